@@ -1,9 +1,17 @@
 package app
 
 import (
+	"bytes"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"io/fs"
 	"log"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/km-arc/go-laravel/framework/config"
 	"github.com/km-arc/go-laravel/framework/container"
@@ -64,13 +72,38 @@ func (a *Application) Views() *gohttp.ViewEngine {
 	return container.Resolve[*gohttp.ViewEngine](a.Container, "view")
 }
 
-// Run boots the application (if needed) and starts the HTTP server.
+// Run boots the application (if needed), checks the routes (see
+// routing.Router.Validate) and starts the HTTP server.
+// A route command as the first argument runs instead: `go run . route:list
+// [flags]` (see RouteList), route:cache (RouteCache) or route:clear
+// (RouteClear).
 func (a *Application) Run() {
 	if !a.Providers.Booted() {
 		a.Boot()
 	}
+	if cmd := a.command(); strings.HasPrefix(cmd, "route:") {
+		var err error
+		switch cmd {
+		case "route:list":
+			err = a.RouteList(os.Stdout, os.Args[2:])
+		case "route:cache":
+			err = a.RouteCache(os.Stdout)
+		case "route:clear":
+			err = a.RouteClear(os.Stdout)
+		default:
+			err = fmt.Errorf("unknown command")
+		}
+		if err != nil {
+			log.Fatalf("%s: %v", cmd, err)
+		}
+		return
+	}
 	cfg := a.Config()
 	router := a.Router()
+	if err := router.Validate(); err != nil {
+		log.Fatalf("routes: %v", err)
+	}
+	router.Handler() // compile now: middleware missing APP_KEY panics here
 	addr := ":" + cfg.App.Port
 	fmt.Printf("🚀  %s running on http://localhost%s  [%s]\n",
 		cfg.App.Name, addr, cfg.App.Env)
@@ -79,6 +112,121 @@ func (a *Application) Run() {
 	}
 }
 
+// RouteList writes the registered routes to w, parsing route:list flags
+// from args: --method, --name and --path filter, --json switches the format.
+//
+//	// Laravel: php artisan route:list --method=GET --path=api
+//	// go run . route:list --method=GET --path=api --json
+func (a *Application) RouteList(w io.Writer, args []string) error {
+	var filter routing.RouteFilter
+	fs := flag.NewFlagSet("route:list", flag.ContinueOnError)
+	fs.SetOutput(w)
+	fs.StringVar(&filter.Method, "method", "", "filter by HTTP method")
+	fs.StringVar(&filter.Name, "name", "", "filter by route name")
+	fs.StringVar(&filter.Path, "path", "", "filter by URI")
+	asJSON := fs.Bool("json", false, "output JSON")
+	fs.String("env", "", "environment (see DetectEnvironment)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	routes := a.Router().List(filter)
+	if *asJSON {
+		return routing.WriteRouteJSON(w, routes)
+	}
+	return routing.WriteRouteTable(w, routes)
+}
+
+// RouteCachePath is where route:cache writes the routes, relative to the
+// working directory.
+const RouteCachePath = "bootstrap/cache/routes.json"
+
+// Routes registers the application's routes by calling fn, or loads them
+// from RouteCachePath when `go run . route:cache` has written it. Handlers
+// and middleware aliases the cached routes use must be registered outside
+// fn, as fn does not run then (see routing.Router.RegisterHandler).
+//
+//	// Laravel: ->withRouting(web: __DIR__.'/../routes/web.php')
+//	application.Routes(func(r *routing.Router) {
+//	    r.Get("/users", "users.index").Name("users.index")
+//	})
+func (a *Application) Routes(fn func(r *routing.Router)) {
+	router := a.Router()
+	if !a.RoutesAreCached() {
+		fn(router)
+		return
+	}
+	f, err := os.Open(RouteCachePath)
+	if err != nil {
+		log.Fatalf("route cache: %v", err)
+	}
+	defer f.Close()
+	if err := router.LoadCache(f); err != nil {
+		log.Fatalf("route cache: %v (run route:clear)", err)
+	}
+}
+
+// RoutesAreCached reports whether Routes loads the route cache. It never
+// does while route:cache runs, which rebuilds it.
+func (a *Application) RoutesAreCached() bool {
+	if a.command() == "route:cache" {
+		return false
+	}
+	_, err := os.Stat(RouteCachePath)
+	return err == nil
+}
+
+// RouteCache validates the routes and writes them to RouteCachePath.
+//
+//	// Laravel: php artisan route:cache
+//	// go run . route:cache
+func (a *Application) RouteCache(w io.Writer) error {
+	var buf bytes.Buffer
+	if err := a.Router().Cache(&buf); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(RouteCachePath), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(RouteCachePath, buf.Bytes(), 0o644); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w, "Routes cached successfully.")
+	return err
+}
+
+// RouteClear removes the route cache.
+//
+//	// Laravel: php artisan route:clear
+func (a *Application) RouteClear(w io.Writer) error {
+	if err := os.Remove(RouteCachePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	_, err := fmt.Fprintln(w, "Route cache cleared successfully.")
+	return err
+}
+
+// command returns the command-line command, e.g. "route:list", or "".
+func (a *Application) command() string {
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		return os.Args[1]
+	}
+	return ""
+}
+
+// DetectEnvironment reports the environment the configuration is loaded for:
+// an --env flag in args (default: os.Args[1:]), then APP_ENV from the process,
+// then "testing" under `go test`, then APP_ENV from .env.
+//
+//	// Laravel: $app->detectEnvironment(fn () => ...)
+//	// go run . --env=staging
+func (a *Application) DetectEnvironment(args ...string) string {
+	if len(args) == 0 {
+		args = os.Args[1:]
+	}
+	return config.DetectEnvironment(args)
+}
+
 // Environment returns APP_ENV value.
 func (a *Application) Environment() string { return a.Config().App.Env }
 func (a *Application) IsLocal() bool       { return a.Environment() == "local" }
@@ -95,4 +243,4 @@ func (c *Controller) Request(r *http.Request) *gohttp.Request {
 }
 func (c *Controller) Response(w http.ResponseWriter) *gohttp.Response {
 	return gohttp.NewResponse(w)
-}
\ No newline at end of file
+}
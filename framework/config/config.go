@@ -1,8 +1,11 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"sync"
+	"sync/atomic"
 
 	"github.com/joho/godotenv"
 )
@@ -11,8 +14,13 @@ import (
 // Embed or extend it in your app's own AppConfig.
 type Config struct {
 	App  AppConfig
-	DB   DBConfig
-	Mail MailConfig
+	DB   DBConfig   // the default database connection
+	Mail MailConfig // the default mailer
+
+	Database DatabaseConfig // all named database connections
+	Mailers  MailersConfig  // all named mailers
+
+	CORS CORSConfig
 }
 
 type AppConfig struct {
@@ -21,69 +29,154 @@ type AppConfig struct {
 	Debug bool
 	URL   string
 	Port  string
-	Key   string
+	Key   Secret
 }
 
 type DBConfig struct {
+	Name     string // connection name, e.g. "mysql"
 	Driver   string
+	URL      string
 	Host     string
 	Port     string
 	Database string
 	Username string
-	Password string
+	Password Secret
+	Charset  string
+	Prefix   string
+
+	// Read/write split — hosts override Host for reads or writes.
+	ReadHosts  []string
+	WriteHosts []string
+	Sticky     bool
 }
 
 type MailConfig struct {
-	Driver string
-	Host   string
-	Port   string
-	From   string
+	Name       string // mailer name, e.g. "smtp"
+	Driver     string // transport: smtp | sendmail | log | array | ...
+	Host       string
+	Port       string
+	Username   string
+	Password   Secret
+	Encryption string
+	From       string
+	FromName   string
 }
 
-// Load reads .env (if present) and populates a Config from environment variables.
-// Call once at bootstrap: cfg := config.Load()
+// Load reads the env files (if present) and populates a Config from
+// environment variables. Call once at bootstrap: cfg := config.Load()
+//
+// Without arguments the environment is detected (see DetectEnvironment) and
+// .env, .env.{APP_ENV} and .env.local are layered, later files overriding
+// earlier ones. Explicit files are layered the same way, in the given order.
+// Variables set in the real process environment always win over files.
+//
+// A missing file is skipped; if file.encrypted exists instead and
+// LARAVEL_ENV_ENCRYPTION_KEY is set, it is decrypted in memory.
+// KEY_FILE and secret:// references are resolved here too (see SecretProvider).
+// Load panics when an encrypted file or a secret cannot be read.
 func Load(envFiles ...string) *Config {
+	cfg, err := load(envFiles)
+	if err != nil {
+		panic("config: " + err.Error())
+	}
+	return cfg
+}
+
+// load implements Load, returning decryption and secret errors instead of panicking.
+func load(envFiles []string) (*Config, error) {
 	files := envFiles
+	var forced map[string]string
 	if len(files) == 0 {
-		files = []string{".env"}
+		environment, fromFlag := detectEnvironment(os.Args[1:])
+		files = EnvFiles(environment)
+		if fromFlag {
+			// --env beats everything, including a process APP_ENV
+			forced = map[string]string{"APP_ENV": environment}
+		} else if environment != "" {
+			forced = map[string]string{}
+			if _, real := processEnv("APP_ENV"); !real {
+				forced["APP_ENV"] = environment
+			}
+		}
+	}
+	// The Config is built from the new values before they are published, so
+	// a failed load changes nothing and readers never see a partial one.
+	loadMu.Lock()
+	defer loadMu.Unlock()
+	// Non-fatal: env files may not exist in production
+	values, err := readEnv(files, forced)
+	if err != nil {
+		return nil, err
+	}
+	forgetSecrets()
+	cfg, err := build(values)
+	if err != nil {
+		return nil, err
 	}
-	// Non-fatal: .env may not exist in production
-	_ = godotenv.Load(files...)
+	publishEnv(values)
+	return cfg, nil
+}
 
-	return &Config{
+// build populates a Config from the file values over the process
+// environment.
+func build(files map[string]string) (*Config, error) {
+	r := &reader{files: files}
+	cfg := &Config{
 		App: AppConfig{
-			Name:  env("APP_NAME", "GoLaravel"),
-			Env:   env("APP_ENV", "local"),
-			Debug: envBool("APP_DEBUG", true),
-			URL:   env("APP_URL", "http://localhost"),
-			Port:  env("APP_PORT", "8000"),
-			Key:   env("APP_KEY", ""),
-		},
-		DB: DBConfig{
-			Driver:   env("DB_DRIVER", "mysql"),
-			Host:     env("DB_HOST", "127.0.0.1"),
-			Port:     env("DB_PORT", "3306"),
-			Database: env("DB_DATABASE", ""),
-			Username: env("DB_USERNAME", "root"),
-			Password: env("DB_PASSWORD", ""),
-		},
-		Mail: MailConfig{
-			Driver: env("MAIL_DRIVER", "smtp"),
-			Host:   env("MAIL_HOST", ""),
-			Port:   env("MAIL_PORT", "587"),
-			From:   env("MAIL_FROM_ADDRESS", ""),
+			Name:  r.env("APP_NAME", "GoLaravel"),
+			Env:   r.env("APP_ENV", "local"),
+			Debug: r.bool("APP_DEBUG", true),
+			URL:   r.env("APP_URL", "http://localhost"),
+			Port:  r.env("APP_PORT", "8000"),
+			Key:   Secret(r.env("APP_KEY", "")),
 		},
+		Database: r.database(),
+		Mailers:  r.mailers(),
+		CORS:     r.cors(),
+	}
+	cfg.DB, _ = cfg.Database.Connection("")
+	cfg.Mail, _ = cfg.Mailers.Mailer("")
+	if r.err != nil {
+		return nil, r.err
+	}
+	return cfg, nil
+}
+
+// reader looks up env values for build, remembering the first secret that
+// failed to resolve.
+type reader struct {
+	files map[string]string
+	err   error
+}
+
+func (r *reader) env(key, fallback string) string {
+	v, err := resolveIn(r.files, key)
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+	if v == "" {
+		return fallback
+	}
+	return v
+}
+
+func (r *reader) bool(key string, fallback bool) bool {
+	b, err := strconv.ParseBool(r.env(key, ""))
+	if err != nil {
+		return fallback
 	}
+	return b
 }
 
-// Get returns a raw env value, falling back to defaultVal.
+// Get returns an env value, falling back to defaultVal.
+// KEY_FILE and secret:// references are resolved; failures return defaultVal.
 func Get(key, defaultVal string) string {
 	return env(key, defaultVal)
 }
 
 // GetInt returns an int env value.
 func GetInt(key string, defaultVal int) int {
-	v := os.Getenv(key)
+	v := getenv(key)
 	if v == "" {
 		return defaultVal
 	}
@@ -101,15 +194,128 @@ func GetBool(key string, defaultVal bool) bool {
 
 // ── helpers ─────────────────────────────────────────────────────────────────
 
+var (
+	// loadMu serialises loads; readers never take it.
+	loadMu sync.Mutex
+
+	// fileEnv holds the variables the last load took from env files
+	// (key → value). It is replaced as a whole, so readers see one load or
+	// the next, never a mix.
+	fileEnv atomic.Pointer[map[string]string]
+)
+
+// fileValues returns the variables the last load took from env files.
+func fileValues() map[string]string {
+	if m := fileEnv.Load(); m != nil {
+		return *m
+	}
+	return nil
+}
+
+// lookupIn returns key from the file values, then the process environment.
+func lookupIn(files map[string]string, key string) string {
+	if v, ok := files[key]; ok {
+		return v
+	}
+	return os.Getenv(key)
+}
+
+// readEnv merges files in order, later files overriding earlier ones, and
+// returns the variables to take from them: those the real process
+// environment does not set, plus forced ones.
+func readEnv(files []string, forced map[string]string) (map[string]string, error) {
+	merged := make(map[string]string)
+	for _, f := range files {
+		vals, err := readEnvFile(f)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range vals {
+			merged[k] = v
+		}
+	}
+	values := make(map[string]string, len(merged)+len(forced))
+	for k, v := range merged {
+		if _, real := processEnv(k); !real {
+			values[k] = v
+		}
+	}
+	for k, v := range forced {
+		values[k] = v
+	}
+	return values, nil
+}
+
+// publishEnv makes values the file variables and copies them into the
+// process environment for code reading os.Getenv. New values are set
+// before stale ones are unset, so a key present in both loads never
+// disappears.
+func publishEnv(values map[string]string) {
+	old := fileValues()
+	fileEnv.Store(&values)
+	for k, v := range values {
+		_ = os.Setenv(k, v)
+	}
+	for k, v := range old {
+		if _, kept := values[k]; !kept && os.Getenv(k) == v {
+			_ = os.Unsetenv(k)
+		}
+	}
+	forgetSecrets()
+}
+
+// readEnvFile parses an env file, falling back to its .encrypted sibling.
+// It returns no values (and no error) when neither can be read.
+func readEnvFile(path string) (map[string]string, error) {
+	if vals, err := godotenv.Read(path); err == nil {
+		return vals, nil
+	}
+	key := os.Getenv(EnvEncryptionKey)
+	if key == "" {
+		return nil, nil
+	}
+	ciphertext, err := os.ReadFile(path + EncryptedSuffix)
+	if err != nil {
+		return nil, nil
+	}
+	plaintext, err := DecryptEnv(ciphertext, key)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt %s%s: %w", path, EncryptedSuffix, err)
+	}
+	return godotenv.UnmarshalBytes(plaintext)
+}
+
+// processEnv looks up key in the real process environment, ignoring values
+// that were copied in from an env file.
+func processEnv(key string) (string, bool) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return "", false
+	}
+	if loaded, fromFile := fileValues()[key]; fromFile && loaded == v {
+		return "", false
+	}
+	return v, true
+}
+
 func env(key, fallback string) string {
-	if v := os.Getenv(key); v != "" {
+	if v := getenv(key); v != "" {
 		return v
 	}
 	return fallback
 }
 
+// getenv is os.Getenv with secret references resolved.
+func getenv(key string) string {
+	v, err := resolveEnv(key)
+	if err != nil {
+		return ""
+	}
+	return v
+}
+
 func envBool(key string, fallback bool) bool {
-	v := os.Getenv(key)
+	v := getenv(key)
 	if v == "" {
 		return fallback
 	}
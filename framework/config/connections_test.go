@@ -0,0 +1,105 @@
+package config_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/config"
+)
+
+// ── Database connections ─────────────────────────────────────────────────────
+
+func TestDatabase_BuiltinConnections(t *testing.T) {
+	setEnv(t, "DB_PORT", "")
+	cfg := config.Load()
+
+	for _, name := range []string{"mysql", "pgsql", "sqlite"} {
+		c, ok := cfg.Database.Connection(name)
+		if !ok {
+			t.Fatalf("connection %q missing", name)
+		}
+		if c.Driver != name {
+			t.Errorf("%s: Driver got %q", name, c.Driver)
+		}
+	}
+	if pg, _ := cfg.Database.Connection("pgsql"); pg.Port != "5432" {
+		t.Errorf("pgsql Port: got %q want 5432", pg.Port)
+	}
+}
+
+func TestDatabase_DefaultConnectionMirrorsDB(t *testing.T) {
+	setEnv(t, "DB_CONNECTION", "pgsql")
+	setEnv(t, "DB_PGSQL_DATABASE", "reports")
+
+	cfg := config.Load()
+	if cfg.Database.Default != "pgsql" {
+		t.Errorf("Default: got %q want %q", cfg.Database.Default, "pgsql")
+	}
+	if cfg.DB.Name != "pgsql" || cfg.DB.Database != "reports" {
+		t.Errorf("DB: got %s/%s want pgsql/reports", cfg.DB.Name, cfg.DB.Database)
+	}
+}
+
+func TestDatabase_ExtraConnectionAndReadWriteSplit(t *testing.T) {
+	setEnv(t, "DB_CONNECTIONS", "analytics")
+	setEnv(t, "DB_ANALYTICS_DRIVER", "pgsql")
+	setEnv(t, "DB_ANALYTICS_HOST", "10.0.0.9")
+	setEnv(t, "DB_MYSQL_READ_HOST", "10.0.0.2, 10.0.0.3")
+	setEnv(t, "DB_MYSQL_WRITE_HOST", "10.0.0.1")
+	setEnv(t, "DB_MYSQL_STICKY", "true")
+
+	cfg := config.Load()
+
+	a, ok := cfg.Database.Connection("analytics")
+	if !ok || a.Driver != "pgsql" || a.Host != "10.0.0.9" {
+		t.Errorf("analytics: got %+v", a)
+	}
+	m, _ := cfg.Database.Connection("mysql")
+	if !reflect.DeepEqual(m.ReadHosts, []string{"10.0.0.2", "10.0.0.3"}) {
+		t.Errorf("ReadHosts: got %v", m.ReadHosts)
+	}
+	if !reflect.DeepEqual(m.WriteHosts, []string{"10.0.0.1"}) || !m.Sticky {
+		t.Errorf("WriteHosts/Sticky: got %v/%v", m.WriteHosts, m.Sticky)
+	}
+}
+
+func TestDBConfig_DSN(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.DBConfig
+		want string
+	}{
+		{"mysql", config.DBConfig{Driver: "mysql", Host: "h", Port: "3306", Database: "d", Username: "u", Password: "p", Charset: "utf8mb4"},
+			"u:p@tcp(h:3306)/d?charset=utf8mb4&parseTime=true"},
+		{"pgsql", config.DBConfig{Driver: "pgsql", Host: "h", Port: "5432", Database: "d", Username: "u", Password: "p"},
+			"postgres://u:p@h:5432/d"},
+		{"sqlite", config.DBConfig{Driver: "sqlite", Database: "app.sqlite"}, "app.sqlite"},
+		{"url", config.DBConfig{Driver: "mysql", URL: "mysql://x"}, "mysql://x"},
+	}
+	for _, tt := range tests {
+		if got := tt.cfg.DSN(); got != tt.want {
+			t.Errorf("%s: got %q want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// ── Mailers ──────────────────────────────────────────────────────────────────
+
+func TestMailers_DefaultAndNamed(t *testing.T) {
+	setEnv(t, "MAIL_MAILER", "log")
+	setEnv(t, "MAIL_MAILERS", "postmark")
+	setEnv(t, "MAIL_POSTMARK_DRIVER", "postmark")
+	setEnv(t, "MAIL_SMTP_HOST", "smtp.example.com")
+
+	cfg := config.Load()
+
+	if cfg.Mail.Name != "log" || cfg.Mail.Driver != "log" {
+		t.Errorf("default mailer: got %s/%s want log/log", cfg.Mail.Name, cfg.Mail.Driver)
+	}
+	if pm, ok := cfg.Mailers.Mailer("postmark"); !ok || pm.Driver != "postmark" {
+		t.Errorf("postmark: got %+v", pm)
+	}
+	if smtp, _ := cfg.Mailers.Mailer("smtp"); smtp.Host != "smtp.example.com" {
+		t.Errorf("smtp Host: got %q", smtp.Host)
+	}
+}
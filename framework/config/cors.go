@@ -0,0 +1,40 @@
+package config
+
+import "strconv"
+
+// CORSConfig mirrors Laravel's config/cors.php. List values are comma
+// separated in the environment:
+//
+//	CORS_PATHS=api/*,sanctum/csrf-cookie
+//	CORS_ALLOWED_ORIGINS=https://app.example.com,https://*.example.com
+//	CORS_ALLOWED_ORIGINS_PATTERNS=^https://preview-\d+\.example\.dev$
+//	CORS_ALLOWED_METHODS=*
+//	CORS_ALLOWED_HEADERS=*
+//	CORS_EXPOSED_HEADERS=X-RateLimit-Remaining
+//	CORS_MAX_AGE=3600
+//	CORS_SUPPORTS_CREDENTIALS=true
+type CORSConfig struct {
+	Paths                  []string // request paths CORS applies to; "*" wildcards
+	AllowedOrigins         []string // exact origins, "*" or wildcard hosts
+	AllowedOriginsPatterns []string // regular expressions matched against Origin
+	AllowedMethods         []string
+	AllowedHeaders         []string
+	ExposedHeaders         []string
+	MaxAge                 int // seconds preflight responses may be cached; 0 omits the header
+	SupportsCredentials    bool
+}
+
+func (r *reader) cors() CORSConfig {
+	list := func(key, fallback string) []string { return splitList(r.env(key, fallback)) }
+	maxAge, _ := strconv.Atoi(r.env("CORS_MAX_AGE", "0"))
+	return CORSConfig{
+		Paths:                  list("CORS_PATHS", "api/*,sanctum/csrf-cookie"),
+		AllowedOrigins:         list("CORS_ALLOWED_ORIGINS", "*"),
+		AllowedOriginsPatterns: list("CORS_ALLOWED_ORIGINS_PATTERNS", ""),
+		AllowedMethods:         list("CORS_ALLOWED_METHODS", "*"),
+		AllowedHeaders:         list("CORS_ALLOWED_HEADERS", "*"),
+		ExposedHeaders:         list("CORS_EXPOSED_HEADERS", ""),
+		MaxAge:                 maxAge,
+		SupportsCredentials:    r.bool("CORS_SUPPORTS_CREDENTIALS", false),
+	}
+}
@@ -0,0 +1,44 @@
+package config_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/config"
+)
+
+// ── CORS ─────────────────────────────────────────────────────────────────────
+
+func TestCORS_Defaults(t *testing.T) {
+	cfg := config.Load("testdata/empty.env")
+
+	want := config.CORSConfig{
+		Paths:          []string{"api/*", "sanctum/csrf-cookie"},
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"*"},
+		AllowedHeaders: []string{"*"},
+	}
+	if !reflect.DeepEqual(cfg.CORS, want) {
+		t.Errorf("got %+v\nwant %+v", cfg.CORS, want)
+	}
+}
+
+func TestCORS_FromEnv(t *testing.T) {
+	setEnv(t, "CORS_PATHS", "*")
+	setEnv(t, "CORS_ALLOWED_ORIGINS", "https://app.example.com, https://*.example.com")
+	setEnv(t, "CORS_ALLOWED_METHODS", "GET,POST")
+	setEnv(t, "CORS_EXPOSED_HEADERS", "X-RateLimit-Remaining")
+	setEnv(t, "CORS_MAX_AGE", "3600")
+	setEnv(t, "CORS_SUPPORTS_CREDENTIALS", "true")
+
+	c := config.Load("testdata/empty.env").CORS
+	if !reflect.DeepEqual(c.AllowedOrigins, []string{"https://app.example.com", "https://*.example.com"}) {
+		t.Errorf("AllowedOrigins: got %q", c.AllowedOrigins)
+	}
+	if !reflect.DeepEqual(c.AllowedMethods, []string{"GET", "POST"}) {
+		t.Errorf("AllowedMethods: got %q", c.AllowedMethods)
+	}
+	if c.MaxAge != 3600 || !c.SupportsCredentials || c.ExposedHeaders[0] != "X-RateLimit-Remaining" {
+		t.Errorf("got %+v", c)
+	}
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvEncryptionKey is the process environment variable holding the key used
+// to decrypt .env.*.encrypted files at boot.
+const EnvEncryptionKey = "LARAVEL_ENV_ENCRYPTION_KEY"
+
+// EncryptedSuffix is appended to an env file name when it is encrypted.
+const EncryptedSuffix = ".encrypted"
+
+// encryptedPayload is the base64 JSON envelope of an encrypted env file.
+// Its fields are named after Laravel's Encrypter payload, but the plaintext
+// is not PHP-serialised, so files written by `php artisan env:encrypt`
+// cannot be read, nor these by Laravel.
+type encryptedPayload struct {
+	IV    string `json:"iv"`
+	Value string `json:"value"`
+	MAC   string `json:"mac"`
+	Tag   string `json:"tag"`
+}
+
+// GenerateEnvKey returns a new random AES-256 key in "base64:..." form.
+//
+//	key, _ := config.GenerateEnvKey()
+func GenerateEnvKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return "base64:" + base64.StdEncoding.EncodeToString(key), nil
+}
+
+// EncryptEnvFile encrypts path with AES-256-GCM and writes path.encrypted,
+// returning the name of the written file. The plaintext file is left in place.
+// It does the job of Laravel's env:encrypt, in this package's own format.
+//
+//	out, err := config.EncryptEnvFile(".env.production", key) // ".env.production.encrypted"
+func EncryptEnvFile(path, key string) (string, error) {
+	plaintext, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := EncryptEnv(plaintext, key)
+	if err != nil {
+		return "", err
+	}
+	out := path + EncryptedSuffix
+	if err := os.WriteFile(out, ciphertext, 0o644); err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// DecryptEnvFile decrypts an encrypted env file and writes the plaintext next
+// to it (without the .encrypted suffix), returning the written file name.
+// It does the job of Laravel's env:decrypt, for files from EncryptEnvFile.
+//
+//	out, err := config.DecryptEnvFile(".env.production.encrypted", key) // ".env.production"
+func DecryptEnvFile(path, key string) (string, error) {
+	ciphertext, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := DecryptEnv(ciphertext, key)
+	if err != nil {
+		return "", err
+	}
+	out := strings.TrimSuffix(path, EncryptedSuffix)
+	if out == path {
+		out = path + ".decrypted"
+	}
+	if err := os.WriteFile(out, plaintext, 0o600); err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// EncryptEnv encrypts env file contents with AES-256-GCM.
+func EncryptEnv(plaintext []byte, key string) ([]byte, error) {
+	gcm, err := envCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	sealed := gcm.Seal(nil, iv, plaintext, nil)
+	ct, tag := sealed[:len(sealed)-gcm.Overhead()], sealed[len(sealed)-gcm.Overhead():]
+
+	payload, err := json.Marshal(encryptedPayload{
+		IV:    base64.StdEncoding.EncodeToString(iv),
+		Value: base64.StdEncoding.EncodeToString(ct),
+		Tag:   base64.StdEncoding.EncodeToString(tag),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(base64.StdEncoding.EncodeToString(payload)), nil
+}
+
+// DecryptEnv reverses EncryptEnv. It fails if the key is wrong or the
+// payload has been tampered with.
+func DecryptEnv(ciphertext []byte, key string) ([]byte, error) {
+	gcm, err := envCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(ciphertext)))
+	if err != nil {
+		return nil, errors.New("invalid payload")
+	}
+	var p encryptedPayload
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return nil, errors.New("invalid payload")
+	}
+	iv, err1 := base64.StdEncoding.DecodeString(p.IV)
+	ct, err2 := base64.StdEncoding.DecodeString(p.Value)
+	tag, err3 := base64.StdEncoding.DecodeString(p.Tag)
+	if err1 != nil || err2 != nil || err3 != nil || len(iv) != gcm.NonceSize() {
+		return nil, errors.New("invalid payload")
+	}
+	plaintext, err := gcm.Open(nil, iv, append(ct, tag...), nil)
+	if err != nil {
+		return nil, errors.New("the payload could not be decrypted")
+	}
+	return plaintext, nil
+}
+
+// envCipher builds an AES-256-GCM AEAD from a "base64:..." or raw 32-byte key.
+func envCipher(key string) (cipher.AEAD, error) {
+	raw := []byte(key)
+	if b64, ok := strings.CutPrefix(key, "base64:"); ok {
+		decoded, err := base64.StdEncoding.DecodeString(b64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvEncryptionKey, err)
+		}
+		raw = decoded
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("invalid %s: AES-256 requires a 32-byte key, got %d", EnvEncryptionKey, len(raw))
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,110 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/config"
+)
+
+// ── EncryptEnv / DecryptEnv ──────────────────────────────────────────────────
+
+func TestEncryptEnv_RoundTrip(t *testing.T) {
+	key, err := config.GenerateEnvKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := config.EncryptEnv([]byte("DB_PASSWORD=secret\n"), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := config.DecryptEnv(ciphertext, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plaintext) != "DB_PASSWORD=secret\n" {
+		t.Errorf("got %q", plaintext)
+	}
+}
+
+func TestDecryptEnv_WrongKeyFails(t *testing.T) {
+	key, _ := config.GenerateEnvKey()
+	other, _ := config.GenerateEnvKey()
+	ciphertext, _ := config.EncryptEnv([]byte("A=1"), key)
+
+	if _, err := config.DecryptEnv(ciphertext, other); err == nil {
+		t.Error("expected error decrypting with the wrong key")
+	}
+}
+
+func TestEncryptEnv_RejectsShortKey(t *testing.T) {
+	if _, err := config.EncryptEnv([]byte("A=1"), "too-short"); err == nil {
+		t.Error("expected error for a non 32-byte key")
+	}
+}
+
+// ── Load with encrypted files ────────────────────────────────────────────────
+
+func TestLoad_DecryptsEncryptedFile(t *testing.T) {
+	forgetEnvFiles(t)
+	key, _ := config.GenerateEnvKey()
+	setEnv(t, config.EnvEncryptionKey, key)
+
+	path := filepath.Join(t.TempDir(), ".env.production")
+	writeEnv(t, path, "DB_PASSWORD=from-encrypted\n", time.Now())
+	if _, err := config.EncryptEnvFile(path, key); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := config.Load(path).DB.Password; got != "from-encrypted" {
+		t.Errorf("DB.Password: got %q want %q", got, "from-encrypted")
+	}
+}
+
+func TestLoad_PanicsOnUndecryptableFile(t *testing.T) {
+	forgetEnvFiles(t)
+	key, _ := config.GenerateEnvKey()
+	wrong, _ := config.GenerateEnvKey()
+
+	path := filepath.Join(t.TempDir(), ".env.production")
+	writeEnv(t, path, "DB_PASSWORD=x\n", time.Now())
+	if _, err := config.EncryptEnvFile(path, key); err != nil {
+		t.Fatal(err)
+	}
+	_ = os.Remove(path)
+	setEnv(t, config.EnvEncryptionKey, wrong)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Load to panic with the wrong key")
+		}
+	}()
+	config.Load(path)
+}
+
+func TestDecryptEnvFile_WritesPlaintext(t *testing.T) {
+	key, _ := config.GenerateEnvKey()
+	path := filepath.Join(t.TempDir(), ".env.staging")
+	writeEnv(t, path, "APP_NAME=Staging\n", time.Now())
+	enc, err := config.EncryptEnvFile(path, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = os.Remove(path)
+
+	out, err := config.DecryptEnvFile(enc, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != path {
+		t.Errorf("output: got %q want %q", out, path)
+	}
+	if b, _ := os.ReadFile(out); string(b) != "APP_NAME=Staging\n" {
+		t.Errorf("contents: got %q", b)
+	}
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+// DetectEnvironment determines the application environment, in order of
+// precedence:
+//
+//  1. an --env flag in args (--env=staging or --env staging)
+//  2. APP_ENV in the real process environment
+//  3. "testing" when running under `go test`
+//  4. APP_ENV from the base .env file
+//
+// It returns "" when none of these apply; Config.App.Env then defaults to "local".
+//
+//	// Laravel: php artisan migrate --env=staging
+//	env := config.DetectEnvironment(os.Args[1:])
+func DetectEnvironment(args []string) string {
+	environment, _ := detectEnvironment(args)
+	return environment
+}
+
+// EnvFiles returns the layered env files for an environment, lowest
+// precedence first: .env, .env.{environment} and .env.local.
+// .env.local is skipped for "testing" so local overrides never leak into tests.
+//
+//	config.EnvFiles("production") // [.env .env.production .env.local]
+//	config.EnvFiles("testing")    // [.env .env.testing]
+func EnvFiles(environment string) []string {
+	files := []string{".env"}
+	if environment != "" {
+		files = append(files, ".env."+environment)
+	}
+	if environment != "testing" {
+		files = append(files, ".env.local")
+	}
+	return files
+}
+
+// DefaultEnvFiles returns the files Load reads when called without arguments.
+func DefaultEnvFiles() []string {
+	return EnvFiles(DetectEnvironment(os.Args[1:]))
+}
+
+// detectEnvironment implements DetectEnvironment and also reports whether the
+// result came from an explicit --env flag.
+func detectEnvironment(args []string) (string, bool) {
+	if v, ok := envFlag(args); ok {
+		return v, true
+	}
+	if v, ok := processEnv("APP_ENV"); ok && v != "" {
+		return v, false
+	}
+	if runningTests() {
+		return "testing", false
+	}
+	if base, err := godotenv.Read(".env"); err == nil {
+		return base["APP_ENV"], false
+	}
+	return "", false
+}
+
+// envFlag extracts the value of --env from command-line arguments.
+func envFlag(args []string) (string, bool) {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if v, ok := strings.CutPrefix(arg, "--env="); ok {
+			return v, v != ""
+		}
+		if arg == "--env" && i+1 < len(args) {
+			return args[i+1], args[i+1] != ""
+		}
+	}
+	return "", false
+}
+
+// runningTests reports whether the binary was built by `go test`.
+func runningTests() bool {
+	return flag.Lookup("test.v") != nil || strings.HasSuffix(os.Args[0], ".test")
+}
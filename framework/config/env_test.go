@@ -0,0 +1,89 @@
+package config_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/config"
+)
+
+// ── EnvFiles ─────────────────────────────────────────────────────────────────
+
+func TestEnvFiles_Layering(t *testing.T) {
+	tests := []struct {
+		env  string
+		want []string
+	}{
+		{"", []string{".env", ".env.local"}},
+		{"production", []string{".env", ".env.production", ".env.local"}},
+		{"testing", []string{".env", ".env.testing"}},
+	}
+	for _, tt := range tests {
+		if got := config.EnvFiles(tt.env); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("EnvFiles(%q): got %v want %v", tt.env, got, tt.want)
+		}
+	}
+}
+
+// ── DetectEnvironment ────────────────────────────────────────────────────────
+
+func TestDetectEnvironment_FlagWins(t *testing.T) {
+	setEnv(t, "APP_ENV", "production")
+
+	if got := config.DetectEnvironment([]string{"--env=staging"}); got != "staging" {
+		t.Errorf("--env=staging: got %q", got)
+	}
+	if got := config.DetectEnvironment([]string{"serve", "--env", "qa"}); got != "qa" {
+		t.Errorf("--env qa: got %q", got)
+	}
+}
+
+func TestDetectEnvironment_ProcessEnv(t *testing.T) {
+	setEnv(t, "APP_ENV", "production")
+	if got := config.DetectEnvironment(nil); got != "production" {
+		t.Errorf("got %q want %q", got, "production")
+	}
+}
+
+func TestDetectEnvironment_TestingUnderGoTest(t *testing.T) {
+	setEnv(t, "APP_ENV", "")
+	if got := config.DetectEnvironment(nil); got != "testing" {
+		t.Errorf("got %q want %q", got, "testing")
+	}
+}
+
+// ── Layered Load ─────────────────────────────────────────────────────────────
+
+func TestLoad_LaterFilesOverrideEarlier(t *testing.T) {
+	forgetEnvFiles(t)
+	dir := t.TempDir()
+	base := filepath.Join(dir, ".env")
+	local := filepath.Join(dir, ".env.local")
+	writeEnv(t, base, "APP_NAME=Base\nDB_DATABASE=base_db\n", time.Now())
+	writeEnv(t, local, "APP_NAME=Local\n", time.Now())
+
+	cfg := config.Load(base, local)
+
+	if cfg.App.Name != "Local" {
+		t.Errorf("App.Name: got %q want %q", cfg.App.Name, "Local")
+	}
+	if cfg.DB.Database != "base_db" {
+		t.Errorf("DB.Database: got %q want %q", cfg.DB.Database, "base_db")
+	}
+}
+
+func TestLoad_ProcessEnvWinsOverAllLayers(t *testing.T) {
+	forgetEnvFiles(t)
+	setEnv(t, "APP_NAME", "FromProcess")
+	dir := t.TempDir()
+	base := filepath.Join(dir, ".env")
+	local := filepath.Join(dir, ".env.local")
+	writeEnv(t, base, "APP_NAME=Base\n", time.Now())
+	writeEnv(t, local, "APP_NAME=Local\n", time.Now())
+
+	if got := config.Load(base, local).App.Name; got != "FromProcess" {
+		t.Errorf("got %q want %q", got, "FromProcess")
+	}
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// SecretScheme prefixes env values that reference a secret by name, e.g.
+// DB_PASSWORD=secret://db-password.
+const SecretScheme = "secret://"
+
+// SecretProvider resolves secret:// references to their values.
+// Implement it for Vault, AWS Secrets Manager, etc. and bind it into the
+// container as "secrets"; ConfigServiceProvider picks it up at load time.
+//
+//	app.Singleton("secrets", func(c *container.Container) any {
+//	    return vault.NewSecretProvider(...)
+//	})
+type SecretProvider interface {
+	Secret(name string) (string, error)
+}
+
+// FileSecretProvider reads secrets from files in Dir, one secret per file —
+// the layout Docker and Kubernetes use under /run/secrets.
+// A single trailing newline is stripped.
+type FileSecretProvider struct {
+	Dir string
+}
+
+// Secret returns the contents of Dir/name.
+func (p FileSecretProvider) Secret(name string) (string, error) {
+	if name == "" || strings.Contains(name, "..") {
+		return "", fmt.Errorf("invalid secret name %q", name)
+	}
+	return readSecretFile(filepath.Join(p.Dir, name))
+}
+
+// Secret is a string config value that is redacted when printed, logged with
+// %v / %+v / %#v or marshalled to JSON. Use Reveal for the real value.
+//
+//	fmt.Printf("%+v", cfg.DB)   // {... Password:[redacted]}
+//	dsn := cfg.DB.Password.Reveal()
+type Secret string
+
+const redacted = "[redacted]"
+
+// Reveal returns the underlying secret value.
+func (s Secret) Reveal() string { return string(s) }
+
+// String implements fmt.Stringer, hiding non-empty values.
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+// GoString implements fmt.GoStringer for %#v.
+func (s Secret) GoString() string { return fmt.Sprintf("%q", s.String()) }
+
+// MarshalJSON implements json.Marshaler, hiding non-empty values.
+func (s Secret) MarshalJSON() ([]byte, error) { return json.Marshal(s.String()) }
+
+// ── resolution ───────────────────────────────────────────────────────────────
+
+var (
+	secretMu       sync.Mutex
+	secretProvider SecretProvider = FileSecretProvider{Dir: "/run/secrets"}
+
+	// secretCache holds values resolved since the last Load (reference → value).
+	secretCache = map[string]string{}
+)
+
+// SetSecretProvider replaces the provider used for secret:// references.
+// The default is a FileSecretProvider reading /run/secrets.
+func SetSecretProvider(p SecretProvider) {
+	secretMu.Lock()
+	defer secretMu.Unlock()
+	secretProvider = p
+	secretCache = map[string]string{}
+}
+
+// resolveEnv returns the value of key, resolving secret references:
+//   - KEY=secret://name → SecretProvider.Secret("name")
+//   - KEY unset and KEY_FILE=/path → contents of /path (Docker secrets)
+func resolveEnv(key string) (string, error) {
+	return resolveIn(fileValues(), key)
+}
+
+// resolveIn implements resolveEnv over the given file values.
+func resolveIn(files map[string]string, key string) (string, error) {
+	v := lookupIn(files, key)
+	var file string
+	switch {
+	case strings.HasPrefix(v, SecretScheme):
+	case v == "":
+		if file = lookupIn(files, key+"_FILE"); file == "" {
+			return "", nil
+		}
+	default:
+		return v, nil
+	}
+
+	ref := v
+	if file != "" {
+		ref = "file:" + file
+	}
+
+	secretMu.Lock()
+	if cached, ok := secretCache[ref]; ok {
+		secretMu.Unlock()
+		return cached, nil
+	}
+	provider := secretProvider
+	secretMu.Unlock()
+
+	var (
+		resolved string
+		err      error
+	)
+	if file != "" {
+		resolved, err = readSecretFile(file)
+	} else if provider == nil {
+		err = errors.New("no secret provider registered")
+	} else {
+		resolved, err = provider.Secret(strings.TrimPrefix(v, SecretScheme))
+	}
+	if err != nil {
+		return "", fmt.Errorf("resolve %s: %w", key, err)
+	}
+
+	secretMu.Lock()
+	secretCache[ref] = resolved
+	secretMu.Unlock()
+	return resolved, nil
+}
+
+// forgetSecrets clears resolved values so the next lookup re-reads them.
+func forgetSecrets() {
+	secretMu.Lock()
+	defer secretMu.Unlock()
+	secretCache = map[string]string{}
+}
+
+func readSecretFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	s := strings.TrimSuffix(string(b), "\n")
+	return strings.TrimSuffix(s, "\r"), nil
+}
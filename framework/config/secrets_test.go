@@ -0,0 +1,123 @@
+package config_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/config"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+type mapSecrets map[string]string
+
+func (m mapSecrets) Secret(name string) (string, error) {
+	if v, ok := m[name]; ok {
+		return v, nil
+	}
+	return "", errors.New("not found")
+}
+
+func useSecrets(t *testing.T, p config.SecretProvider) {
+	t.Helper()
+	config.SetSecretProvider(p)
+	t.Cleanup(func() { config.SetSecretProvider(config.FileSecretProvider{Dir: "/run/secrets"}) })
+}
+
+// ── KEY_FILE ─────────────────────────────────────────────────────────────────
+
+func TestLoad_ResolvesFileReference(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "db")
+	if err := os.WriteFile(path, []byte("s3cr3t\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	setEnv(t, "DB_PASSWORD", "")
+	setEnv(t, "DB_PASSWORD_FILE", path)
+
+	cfg := config.Load()
+	if got := cfg.DB.Password.Reveal(); got != "s3cr3t" {
+		t.Errorf("DB.Password: got %q want %q", got, "s3cr3t")
+	}
+}
+
+func TestLoad_PlainValueWinsOverFileReference(t *testing.T) {
+	setEnv(t, "DB_PASSWORD", "plain")
+	setEnv(t, "DB_PASSWORD_FILE", "/does/not/exist")
+
+	if got := config.Load().DB.Password.Reveal(); got != "plain" {
+		t.Errorf("got %q want %q", got, "plain")
+	}
+}
+
+// ── secret:// ────────────────────────────────────────────────────────────────
+
+func TestLoad_ResolvesSecretScheme(t *testing.T) {
+	useSecrets(t, mapSecrets{"app-key": "base64:abc"})
+	setEnv(t, "APP_KEY", "secret://app-key")
+
+	if got := config.Load().App.Key.Reveal(); got != "base64:abc" {
+		t.Errorf("App.Key: got %q want %q", got, "base64:abc")
+	}
+}
+
+func TestLoad_PanicsOnUnresolvableSecret(t *testing.T) {
+	useSecrets(t, mapSecrets{})
+	setEnv(t, "DB_PASSWORD", "secret://missing")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Load to panic for an unknown secret")
+		}
+	}()
+	config.Load()
+}
+
+func TestGet_ResolvesSecretScheme(t *testing.T) {
+	useSecrets(t, mapSecrets{"stripe": "sk_test"})
+	setEnv(t, "STRIPE_SECRET", "secret://stripe")
+
+	if got := config.Get("STRIPE_SECRET", ""); got != "sk_test" {
+		t.Errorf("got %q want %q", got, "sk_test")
+	}
+}
+
+func TestFileSecretProvider_ReadsFromDir(t *testing.T) {
+	dir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(dir, "api"), []byte("token\n"), 0o600)
+	p := config.FileSecretProvider{Dir: dir}
+
+	if got, err := p.Secret("api"); err != nil || got != "token" {
+		t.Errorf("got (%q, %v) want (%q, nil)", got, err, "token")
+	}
+	if _, err := p.Secret("../etc/passwd"); err == nil {
+		t.Error("expected error for a path-traversing name")
+	}
+}
+
+// ── Secret redaction ─────────────────────────────────────────────────────────
+
+func TestSecret_RedactedInDumps(t *testing.T) {
+	cfg := config.Config{DB: config.DBConfig{Password: "hunter2"}}
+
+	for _, out := range []string{
+		fmt.Sprintf("%v", cfg),
+		fmt.Sprintf("%+v", cfg),
+		fmt.Sprintf("%#v", cfg),
+	} {
+		if strings.Contains(out, "hunter2") {
+			t.Errorf("secret leaked in dump: %s", out)
+		}
+	}
+	b, _ := json.Marshal(cfg)
+	if strings.Contains(string(b), "hunter2") {
+		t.Errorf("secret leaked in JSON: %s", b)
+	}
+	if cfg.DB.Password.Reveal() != "hunter2" {
+		t.Error("Reveal should return the real value")
+	}
+}
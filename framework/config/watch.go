@@ -0,0 +1,143 @@
+package config
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultWatchInterval is how often a Watcher polls its files for changes.
+const DefaultWatchInterval = 2 * time.Second
+
+// Watcher polls .env / config files and reloads the Config when they change.
+//
+// Each reload builds a brand-new *Config and hands it to the callback — an
+// existing Config is never mutated, so readers holding the previous pointer
+// keep a consistent snapshot while new readers see the fresh one.
+//
+//	w := config.Watch([]string{".env"}, time.Second, func(cfg *config.Config) {
+//	    app.Instance("config", cfg) // fires Rebinding("config", ...) callbacks
+//	})
+//	defer w.Stop()
+type Watcher struct {
+	files    []string
+	envFiles []string
+	interval time.Duration
+	onReload func(*Config)
+
+	mu       sync.Mutex
+	modTimes map[string]time.Time
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// Watch starts polling files and calls onReload with a freshly loaded Config
+// whenever one of them is created, modified or removed.
+// files are loaded as env files — pass nil to watch DefaultEnvFiles and reload
+// with environment detection, exactly like Load(). Use AlsoWatch for files
+// that are only observed (e.g. YAML read by your own providers). An
+// interval <= 0 uses DefaultWatchInterval.
+func Watch(files []string, interval time.Duration, onReload func(*Config)) *Watcher {
+	watched := files
+	if len(watched) == 0 {
+		watched = DefaultEnvFiles()
+	}
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	w := &Watcher{
+		files:    append([]string(nil), watched...),
+		envFiles: append([]string(nil), files...),
+		interval: interval,
+		onReload: onReload,
+		modTimes: make(map[string]time.Time),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	w.snapshot()
+	go w.loop()
+	return w
+}
+
+// AlsoWatch adds files that trigger a reload without being parsed as env files.
+func (w *Watcher) AlsoWatch(files ...string) *Watcher {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, f := range files {
+		w.files = append(w.files, f)
+		w.modTimes[f] = modTime(f)
+	}
+	return w
+}
+
+// Reload forces an immediate reload regardless of file modification times.
+// On error (e.g. an undecryptable .encrypted file) the callback is skipped and
+// the previously published Config stays in place.
+func (w *Watcher) Reload() (*Config, error) {
+	cfg, err := load(w.envFiles)
+	if err != nil {
+		return nil, err
+	}
+	if w.onReload != nil {
+		w.onReload(cfg)
+	}
+	return cfg, nil
+}
+
+// Stop halts polling and waits for the watcher goroutine to exit.
+func (w *Watcher) Stop() {
+	w.once.Do(func() { close(w.stop) })
+	<-w.done
+}
+
+func (w *Watcher) loop() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			if w.changed() {
+				_, _ = w.Reload()
+			}
+		}
+	}
+}
+
+// snapshot records the current modification time of every watched file.
+func (w *Watcher) snapshot() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, f := range w.files {
+		w.modTimes[f] = modTime(f)
+	}
+}
+
+// changed reports whether any watched file differs from the last snapshot,
+// updating the snapshot as it goes.
+func (w *Watcher) changed() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	changed := false
+	for _, f := range w.files {
+		mt := modTime(f)
+		if !mt.Equal(w.modTimes[f]) {
+			w.modTimes[f] = mt
+			changed = true
+		}
+	}
+	return changed
+}
+
+// modTime returns the file's modification time, or the zero time if missing.
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,151 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/config"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func writeEnv(t *testing.T, path, content string, mod time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// Force a distinct mtime — some filesystems have coarse timestamps.
+	if err := os.Chtimes(path, mod, mod); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// forgetEnvFiles unsets everything previously loaded from env files.
+func forgetEnvFiles(t *testing.T) {
+	t.Cleanup(func() { config.Load(filepath.Join(t.TempDir(), "none.env")) })
+}
+
+// ── Load ─────────────────────────────────────────────────────────────────────
+
+func TestLoad_RefreshesValuesFromFiles(t *testing.T) {
+	forgetEnvFiles(t)
+	path := filepath.Join(t.TempDir(), ".env")
+	now := time.Now()
+
+	writeEnv(t, path, "APP_NAME=First\n", now)
+	if got := config.Load(path).App.Name; got != "First" {
+		t.Fatalf("got %q want %q", got, "First")
+	}
+
+	writeEnv(t, path, "APP_NAME=Second\n", now.Add(time.Second))
+	if got := config.Load(path).App.Name; got != "Second" {
+		t.Errorf("got %q want %q", got, "Second")
+	}
+}
+
+func TestLoad_ProcessEnvWinsOverFile(t *testing.T) {
+	forgetEnvFiles(t)
+	setEnv(t, "APP_NAME", "FromProcess")
+	path := filepath.Join(t.TempDir(), ".env")
+	writeEnv(t, path, "APP_NAME=FromFile\n", time.Now())
+
+	if got := config.Load(path).App.Name; got != "FromProcess" {
+		t.Errorf("got %q want %q", got, "FromProcess")
+	}
+}
+
+func TestLoad_ReloadNeverHidesKeys(t *testing.T) {
+	forgetEnvFiles(t)
+	dir := t.TempDir()
+	first, second := filepath.Join(dir, "first.env"), filepath.Join(dir, "second.env")
+	writeEnv(t, first, "RELOAD_KEPT=yes\nRELOAD_N=1\n", time.Now())
+	writeEnv(t, second, "RELOAD_KEPT=yes\nRELOAD_N=2\n", time.Now())
+	config.Load(first)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			config.Load(first, second)
+			config.Load(first)
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			return
+		default:
+			if got := config.Get("RELOAD_KEPT", ""); got != "yes" {
+				t.Fatalf("RELOAD_KEPT disappeared during a reload: %q", got)
+			}
+		}
+	}
+}
+
+func TestLoad_FailedLoadKeepsEnvironment(t *testing.T) {
+	forgetEnvFiles(t)
+	setEnv(t, config.EnvEncryptionKey, "base64:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=")
+	dir := t.TempDir()
+	good := filepath.Join(dir, ".env")
+	writeEnv(t, good, "RELOAD_KEPT=yes\n", time.Now())
+	config.Load(good)
+
+	bad := filepath.Join(dir, "bad.env")
+	writeEnv(t, bad+config.EncryptedSuffix, "not encrypted", time.Now())
+	func() {
+		defer func() { _ = recover() }()
+		config.Load(bad)
+	}()
+	if got := config.Get("RELOAD_KEPT", ""); got != "yes" {
+		t.Errorf("got %q want the previous load kept", got)
+	}
+}
+
+// ── Watch ────────────────────────────────────────────────────────────────────
+
+func TestWatch_ReloadsOnChange(t *testing.T) {
+	forgetEnvFiles(t)
+	path := filepath.Join(t.TempDir(), ".env")
+	now := time.Now()
+	writeEnv(t, path, "APP_NAME=Before\n", now)
+	config.Load(path)
+
+	reloaded := make(chan *config.Config, 1)
+	w := config.Watch([]string{path}, 10*time.Millisecond, func(cfg *config.Config) {
+		select {
+		case reloaded <- cfg:
+		default:
+		}
+	})
+	defer w.Stop()
+
+	writeEnv(t, path, "APP_NAME=After\n", now.Add(time.Second))
+
+	select {
+	case cfg := <-reloaded:
+		if cfg.App.Name != "After" {
+			t.Errorf("App.Name: got %q want %q", cfg.App.Name, "After")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("watcher did not reload after file change")
+	}
+}
+
+func TestWatch_NoReloadWithoutChange(t *testing.T) {
+	forgetEnvFiles(t)
+	path := filepath.Join(t.TempDir(), ".env")
+	writeEnv(t, path, "APP_NAME=Same\n", time.Now())
+
+	calls := make(chan struct{}, 1)
+	w := config.Watch([]string{path}, 10*time.Millisecond, func(*config.Config) {
+		calls <- struct{}{}
+	})
+	time.Sleep(50 * time.Millisecond)
+	w.Stop()
+
+	if len(calls) != 0 {
+		t.Error("expected no reload when files are unchanged")
+	}
+}
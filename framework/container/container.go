@@ -109,12 +109,16 @@ func (c *Container) Singleton(abstract string, factory Factory) {
 //
 //	// Laravel: $app->instance(Config::class, $config)
 //	c.Instance("config", myConfig)
+//
+// Re-registering an abstract swaps the instance atomically and fires any
+// Rebinding callbacks, so Instance is also how live values are republished.
 func (c *Container) Instance(abstract string, instance any) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-	delete(c.bindings, c.canonical(abstract))
 	key := c.canonical(abstract)
+	delete(c.bindings, key)
 	c.instances[key] = instance
+	c.mu.Unlock()
+
 	c.fireRebound(abstract, instance)
 }
 
@@ -446,4 +450,4 @@ func MustResolve[T any](c *Container, abstract string) (T, bool) {
 	instance := c.Make(abstract)
 	typed, ok := instance.(T)
 	return typed, ok
-}
\ No newline at end of file
+}
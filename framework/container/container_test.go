@@ -0,0 +1,25 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/container"
+)
+
+// ── Instance / Rebinding ──────────────────────────────────────────────────────
+
+func TestContainer_Instance_FiresRebinding(t *testing.T) {
+	c := container.New()
+	c.Instance("config", "v1")
+
+	var got any
+	c.Rebinding("config", func(instance any) { got = instance })
+	c.Instance("config", "v2")
+
+	if got != "v2" {
+		t.Errorf("rebinding callback: got %v, want 'v2'", got)
+	}
+	if v := c.Make("config"); v != "v2" {
+		t.Errorf("Make after Instance: got %v, want 'v2'", v)
+	}
+}
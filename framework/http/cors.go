@@ -0,0 +1,196 @@
+package http
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/km-arc/go-laravel/framework/config"
+)
+
+// ── CORS ─────────────────────────────────────────────────────────────────────
+
+// CORS is global middleware answering cross-origin requests from a
+// config.CORSConfig — Laravel's HandleCors. Install it on the root router so
+// preflight OPTIONS requests are answered before routing, even for routes
+// that only register GET or POST.
+//
+//	cors := gohttp.NewCORS(cfg.CORS)
+//	router.Middleware(cors.Middleware)
+type CORS struct {
+	mu     sync.RWMutex
+	policy *corsPolicy
+}
+
+// corsPolicy is a CORSConfig compiled for matching. It is never mutated, so
+// requests keep using the one they started with across Configure calls.
+type corsPolicy struct {
+	credentials    bool
+	paths          []*regexp.Regexp
+	origins        map[string]bool
+	originPatterns []*regexp.Regexp // wildcard origins and AllowedOriginsPatterns
+	anyOrigin      bool
+	anyMethod      bool
+	anyHeader      bool
+	methods        string
+	headers        string
+	exposed        string
+	maxAge         string
+}
+
+// NewCORS creates the middleware. Invalid origin patterns are ignored.
+func NewCORS(cfg config.CORSConfig) *CORS {
+	c := &CORS{}
+	c.Configure(cfg)
+	return c
+}
+
+// Configure replaces the configuration, e.g. after a config reload.
+func (c *CORS) Configure(cfg config.CORSConfig) {
+	p := &corsPolicy{
+		credentials: cfg.SupportsCredentials,
+		origins:     map[string]bool{},
+		anyMethod:   slices.Contains(cfg.AllowedMethods, "*"),
+		anyHeader:   slices.Contains(cfg.AllowedHeaders, "*"),
+		methods:     strings.ToUpper(strings.Join(cfg.AllowedMethods, ", ")),
+		headers:     strings.Join(cfg.AllowedHeaders, ", "),
+		exposed:     strings.Join(cfg.ExposedHeaders, ", "),
+	}
+	for _, path := range cfg.Paths {
+		p.paths = append(p.paths, wildcard(strings.TrimPrefix(path, "/")))
+	}
+	for _, o := range cfg.AllowedOrigins {
+		switch {
+		case o == "*":
+			p.anyOrigin = true
+		case strings.Contains(o, "*"):
+			p.originPatterns = append(p.originPatterns, wildcard(o))
+		default:
+			p.origins[o] = true
+		}
+	}
+	for _, pattern := range cfg.AllowedOriginsPatterns {
+		if re, err := regexp.Compile(pattern); err == nil {
+			p.originPatterns = append(p.originPatterns, re)
+		}
+	}
+	if cfg.MaxAge > 0 {
+		p.maxAge = strconv.Itoa(cfg.MaxAge)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.policy = p
+}
+
+// Middleware answers preflight requests and decorates actual CORS requests
+// on matching paths; everything else passes through untouched.
+func (c *CORS) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := c.current()
+		if !p.matchesPath(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if isPreflight(r) {
+			p.preflight(w, r)
+			return
+		}
+		p.allowOrigin(w, r)
+		if p.exposed != "" && r.Header.Get("Origin") != "" {
+			w.Header().Set("Access-Control-Expose-Headers", p.exposed)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IsAllowedOrigin reports whether origin may make cross-origin requests.
+func (c *CORS) IsAllowedOrigin(origin string) bool {
+	return c.current().allowed(origin)
+}
+
+func (c *CORS) current() *corsPolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.policy
+}
+
+func (p *corsPolicy) preflight(w http.ResponseWriter, r *http.Request) {
+	h := w.Header()
+	p.allowOrigin(w, r)
+
+	h.Add("Vary", "Access-Control-Request-Method")
+	if p.anyMethod {
+		h.Set("Access-Control-Allow-Methods", strings.ToUpper(r.Header.Get("Access-Control-Request-Method")))
+	} else {
+		h.Set("Access-Control-Allow-Methods", p.methods)
+	}
+
+	h.Add("Vary", "Access-Control-Request-Headers")
+	if p.anyHeader {
+		if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+			h.Set("Access-Control-Allow-Headers", requested)
+		}
+	} else if p.headers != "" {
+		h.Set("Access-Control-Allow-Headers", p.headers)
+	}
+
+	if p.maxAge != "" {
+		h.Set("Access-Control-Max-Age", p.maxAge)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// allowOrigin sets Access-Control-Allow-Origin (and -Credentials) for r.
+func (p *corsPolicy) allowOrigin(w http.ResponseWriter, r *http.Request) {
+	h := w.Header()
+	origin := r.Header.Get("Origin")
+
+	if p.anyOrigin && !p.credentials {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		// The response depends on Origin; caches must key on it.
+		h.Add("Vary", "Origin")
+		if origin != "" && p.allowed(origin) {
+			h.Set("Access-Control-Allow-Origin", origin)
+		}
+	}
+	if p.credentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+func (p *corsPolicy) allowed(origin string) bool {
+	if p.anyOrigin || p.origins[origin] {
+		return true
+	}
+	for _, re := range p.originPatterns {
+		if re.MatchString(origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *corsPolicy) matchesPath(path string) bool {
+	path = strings.TrimPrefix(path, "/")
+	for _, re := range p.paths {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPreflight(r *http.Request) bool {
+	return r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+}
+
+// wildcard compiles a Str::is-style pattern: "*" matches any run of characters.
+func wildcard(pattern string) *regexp.Regexp {
+	quoted := strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+	return regexp.MustCompile("^" + quoted + "$")
+}
@@ -0,0 +1,148 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/config"
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func corsConfig() config.CORSConfig {
+	return config.CORSConfig{
+		Paths:          []string{"api/*"},
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"*"},
+		AllowedHeaders: []string{"*"},
+	}
+}
+
+// corsRouter registers only GET /api/users, behind the CORS middleware.
+func corsRouter(cfg config.CORSConfig) http.Handler {
+	r := routing.New()
+	r.Middleware(gohttp.NewCORS(cfg).Middleware)
+	r.Get("/api/users", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return r
+}
+
+func corsRequest(h http.Handler, method, path, origin string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	return rr
+}
+
+// ── Preflight ─────────────────────────────────────────────────────────────────
+
+func TestCORS_PreflightOnGetOnlyRoute(t *testing.T) {
+	h := corsRouter(corsConfig())
+	rr := corsRequest(h, http.MethodOptions, "/api/users", "https://app.test",
+		"Access-Control-Request-Method", "post",
+		"Access-Control-Request-Headers", "Content-Type, X-Requested-With")
+
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("status: got %d want 204", rr.Code)
+	}
+	want := map[string]string{
+		"Access-Control-Allow-Origin":  "*",
+		"Access-Control-Allow-Methods": "POST",
+		"Access-Control-Allow-Headers": "Content-Type, X-Requested-With",
+	}
+	for k, v := range want {
+		if got := rr.Header().Get(k); got != v {
+			t.Errorf("%s: got %q want %q", k, got, v)
+		}
+	}
+}
+
+func TestCORS_PreflightExplicitLists(t *testing.T) {
+	cfg := corsConfig()
+	cfg.AllowedMethods = []string{"get", "post"}
+	cfg.AllowedHeaders = []string{"Content-Type"}
+	cfg.MaxAge = 600
+	rr := corsRequest(corsRouter(cfg), http.MethodOptions, "/api/users", "https://app.test",
+		"Access-Control-Request-Method", "DELETE")
+
+	if got := rr.Header().Get("Access-Control-Allow-Methods"); got != "GET, POST" {
+		t.Errorf("Allow-Methods: got %q", got)
+	}
+	if got := rr.Header().Get("Access-Control-Allow-Headers"); got != "Content-Type" {
+		t.Errorf("Allow-Headers: got %q", got)
+	}
+	if got := rr.Header().Get("Access-Control-Max-Age"); got != "600" {
+		t.Errorf("Max-Age: got %q", got)
+	}
+}
+
+// ── Origins ───────────────────────────────────────────────────────────────────
+
+func TestCORS_AllowedOrigins(t *testing.T) {
+	cfg := corsConfig()
+	cfg.AllowedOrigins = []string{"https://app.example.com", "https://*.example.org"}
+	cfg.AllowedOriginsPatterns = []string{`^https://preview-\d+\.example\.dev$`}
+	c := gohttp.NewCORS(cfg)
+
+	cases := map[string]bool{
+		"https://app.example.com":        true,
+		"https://admin.example.org":      true,
+		"https://preview-42.example.dev": true,
+		"https://evil.example.com":       false,
+		"https://preview-x.example.dev":  false,
+	}
+	for origin, want := range cases {
+		if got := c.IsAllowedOrigin(origin); got != want {
+			t.Errorf("%s: got %v want %v", origin, got, want)
+		}
+	}
+}
+
+func TestCORS_ActualRequestWithCredentials(t *testing.T) {
+	cfg := corsConfig()
+	cfg.AllowedOrigins = []string{"https://app.test"}
+	cfg.SupportsCredentials = true
+	cfg.ExposedHeaders = []string{"X-RateLimit-Remaining"}
+	h := corsRouter(cfg)
+
+	rr := corsRequest(h, http.MethodGet, "/api/users", "https://app.test")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status: got %d", rr.Code)
+	}
+	for k, v := range map[string]string{
+		"Access-Control-Allow-Origin":      "https://app.test",
+		"Access-Control-Allow-Credentials": "true",
+		"Access-Control-Expose-Headers":    "X-RateLimit-Remaining",
+		"Vary":                             "Origin",
+	} {
+		if got := rr.Header().Get(k); got != v {
+			t.Errorf("%s: got %q want %q", k, got, v)
+		}
+	}
+
+	rr = corsRequest(h, http.MethodGet, "/api/users", "https://evil.test")
+	if got := rr.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("disallowed origin got Allow-Origin %q", got)
+	}
+}
+
+func TestCORS_OtherPathsUntouched(t *testing.T) {
+	r := routing.New()
+	r.Middleware(gohttp.NewCORS(corsConfig()).Middleware)
+	r.Get("/web", func(w http.ResponseWriter, _ *http.Request) {})
+
+	rr := corsRequest(r, http.MethodGet, "/web", "https://app.test")
+	if got := rr.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("non-CORS path got Allow-Origin %q", got)
+	}
+}
@@ -0,0 +1,226 @@
+package http
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"html/template"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ── CSRF ─────────────────────────────────────────────────────────────────────
+
+const (
+	// CSRFField is the form field carrying the token — Laravel's _token.
+	CSRFField = "_token"
+	// CSRFHeader and XSRFHeader are the headers checked when the form field
+	// is absent; XSRFHeader is what Axios sends from the XSRFCookie.
+	CSRFHeader = "X-CSRF-TOKEN"
+	XSRFHeader = "X-XSRF-TOKEN"
+	// XSRFCookie holds the token for JavaScript clients (not HttpOnly).
+	XSRFCookie = "XSRF-TOKEN"
+
+	// StatusPageExpired is Laravel's status for a CSRF token mismatch.
+	StatusPageExpired = 419
+
+	csrfSessionCookie = "csrf_session"
+)
+
+// CSRF is VerifyCsrfToken-style middleware. Each browser session gets a
+// random token, kept in an HttpOnly cookie signed with the application key;
+// POST, PUT, PATCH and DELETE requests must echo it in the _token field or
+// the X-CSRF-TOKEN / X-XSRF-TOKEN header, or they are rejected with 419.
+//
+// RoutingServiceProvider registers it as the "csrf" alias in the "web" group.
+//
+//	csrf := gohttp.NewCSRF(cfg.App.Key.Reveal()).Except("webhooks/*")
+//	router.With("csrf").Post("/profile", updateProfile)
+type CSRF struct {
+	mu     sync.RWMutex
+	key    []byte
+	except []*regexp.Regexp
+}
+
+// NewCSRF creates the middleware, signing session tokens with key (APP_KEY).
+// The key is checked when the middleware wraps a route, not here, so apps
+// without CSRF-protected routes run without one.
+func NewCSRF(key string) *CSRF {
+	return &CSRF{key: []byte(key)}
+}
+
+// SetKey replaces the signing key, e.g. after a config reload.
+// Sessions signed with the old key get a fresh token. An empty key is
+// ignored: tokens keep being signed with the current one.
+func (c *CSRF) SetKey(key string) {
+	if key == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.key = []byte(key)
+}
+
+// Except excludes URIs from verification. Patterns match the path without
+// its leading slash or the full URL; "*" is a wildcard.
+//
+//	// Laravel: protected $except = ['stripe/*'];
+//	csrf.Except("stripe/*", "https://example.com/hooks/*")
+func (c *CSRF) Except(uris ...string) *CSRF {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, uri := range uris {
+		c.except = append(c.except, wildcard(strings.TrimPrefix(uri, "/")))
+	}
+	return c
+}
+
+// Middleware issues the session token and verifies it on unsafe methods.
+// Like signed URLs, it refuses to run without an application key: it
+// panics when wrapping a route, as the routes compile.
+func (c *CSRF) Middleware(next http.Handler) http.Handler {
+	c.mu.RLock()
+	missing := len(c.key) == 0
+	c.mu.RUnlock()
+	if missing {
+		panic("csrf: no signing key set — configure APP_KEY")
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := c.sessionToken(r)
+		if token == "" {
+			token = newCSRFToken()
+			c.issue(w, r, token)
+		}
+		r = r.WithContext(context.WithValue(r.Context(), csrfKey{}, token))
+
+		if !isReading(r) && !c.excepted(r) && !tokensMatch(r, token) {
+			NewResponse(w).Error(StatusPageExpired, "CSRF token mismatch.")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// CSRFToken returns the current session's token — Laravel's csrf_token().
+// It is empty outside the CSRF middleware.
+func CSRFToken(r *http.Request) string {
+	token, _ := r.Context().Value(csrfKey{}).(string)
+	return token
+}
+
+// CSRFFuncs returns the csrf_token and csrf_field template helpers bound to
+// r. With a nil request they fail at render time, which lets templates parse
+// before a request exists; see ViewEngine.RequestFuncs.
+//
+//	<form method="POST" action="/profile">
+//	    {{ csrf_field }}
+//	</form>
+func CSRFFuncs(r *http.Request) template.FuncMap {
+	token := func() (string, error) {
+		if r == nil {
+			return "", errCSRFNoRequest
+		}
+		if t := CSRFToken(r); t != "" {
+			return t, nil
+		}
+		return "", errors.New("csrf: route is not behind the csrf middleware")
+	}
+	return template.FuncMap{
+		"csrf_token": token,
+		"csrf_field": func() (template.HTML, error) {
+			t, err := token()
+			if err != nil {
+				return "", err
+			}
+			return template.HTML(`<input type="hidden" name="` + CSRFField + `" value="` +
+				template.HTMLEscapeString(t) + `">`), nil
+		},
+	}
+}
+
+var errCSRFNoRequest = errors.New("csrf: render with ViewEngine.Request(r) to use csrf helpers")
+
+type csrfKey struct{}
+
+// sessionToken returns the token from a correctly signed session cookie.
+func (c *CSRF) sessionToken(r *http.Request) string {
+	cookie, err := r.Cookie(csrfSessionCookie)
+	if err != nil {
+		return ""
+	}
+	token, sig, ok := strings.Cut(cookie.Value, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(c.sign(token))) {
+		return ""
+	}
+	return token
+}
+
+// issue stores a new session token and exposes it to JavaScript.
+func (c *CSRF) issue(w http.ResponseWriter, r *http.Request, token string) {
+	secure := Scheme(r) == "https"
+	http.SetCookie(w, &http.Cookie{
+		Name: csrfSessionCookie, Value: token + "." + c.sign(token),
+		Path: "/", HttpOnly: true, Secure: secure, SameSite: http.SameSiteLaxMode,
+	})
+	http.SetCookie(w, &http.Cookie{
+		Name: XSRFCookie, Value: token,
+		Path: "/", Secure: secure, SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// sign returns the hex HMAC-SHA256 of token. Middleware has checked that
+// there is a key, and SetKey never removes it.
+func (c *CSRF) sign(token string) string {
+	c.mu.RLock()
+	key := c.key
+	c.mu.RUnlock()
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *CSRF) excepted(r *http.Request) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	full := Scheme(r) + "://" + r.Host + r.URL.Path
+	for _, re := range c.except {
+		if re.MatchString(path) || re.MatchString(full) {
+			return true
+		}
+	}
+	return false
+}
+
+// isReading reports whether r uses a safe method — Laravel: isReading().
+func isReading(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+func tokensMatch(r *http.Request, token string) bool {
+	sent := r.PostFormValue(CSRFField)
+	if sent == "" {
+		sent = r.Header.Get(CSRFHeader)
+	}
+	if sent == "" {
+		sent = r.Header.Get(XSRFHeader)
+	}
+	return sent != "" && subtle.ConstantTimeCompare([]byte(sent), []byte(token)) == 1
+}
+
+func newCSRFToken() string {
+	b := make([]byte, 30)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,153 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func csrfHandler(c *gohttp.CSRF) http.Handler {
+	return c.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(gohttp.CSRFToken(r)))
+	}))
+}
+
+// csrfSession performs a GET and returns the issued token and cookies.
+func csrfSession(t *testing.T, h http.Handler) (string, []*http.Cookie) {
+	t.Helper()
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/form", nil))
+	if rr.Body.Len() == 0 {
+		t.Fatal("no token issued")
+	}
+	return rr.Body.String(), rr.Result().Cookies()
+}
+
+func csrfPost(h http.Handler, path string, cookies []*http.Cookie, form url.Values, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	return rr
+}
+
+// ── Verification ──────────────────────────────────────────────────────────────
+
+func TestCSRF_IssuesTokenAndCookies(t *testing.T) {
+	token, cookies := csrfSession(t, csrfHandler(gohttp.NewCSRF("key")))
+
+	var xsrf *http.Cookie
+	for _, c := range cookies {
+		if c.Name == gohttp.XSRFCookie {
+			xsrf = c
+		}
+	}
+	if xsrf == nil || xsrf.Value != token || xsrf.HttpOnly {
+		t.Errorf("XSRF-TOKEN cookie: got %+v", xsrf)
+	}
+}
+
+func TestCSRF_AcceptsFieldAndHeaders(t *testing.T) {
+	h := csrfHandler(gohttp.NewCSRF("key"))
+	token, cookies := csrfSession(t, h)
+
+	cases := map[string]*httptest.ResponseRecorder{
+		"_token":       csrfPost(h, "/form", cookies, url.Values{"_token": {token}}),
+		"X-CSRF-TOKEN": csrfPost(h, "/form", cookies, nil, gohttp.CSRFHeader, token),
+		"X-XSRF-TOKEN": csrfPost(h, "/form", cookies, nil, gohttp.XSRFHeader, token),
+	}
+	for name, rr := range cases {
+		if rr.Code != http.StatusOK || rr.Body.String() != token {
+			t.Errorf("%s: got %d %q", name, rr.Code, rr.Body.String())
+		}
+	}
+}
+
+func TestCSRF_RejectsMismatch(t *testing.T) {
+	h := csrfHandler(gohttp.NewCSRF("key"))
+	token, cookies := csrfSession(t, h)
+
+	cases := map[string]*httptest.ResponseRecorder{
+		"missing":    csrfPost(h, "/form", cookies, nil),
+		"wrong":      csrfPost(h, "/form", cookies, url.Values{"_token": {"nope"}}),
+		"no session": csrfPost(h, "/form", nil, url.Values{"_token": {token}}),
+		"other key":  csrfPost(csrfHandler(gohttp.NewCSRF("other")), "/form", cookies, url.Values{"_token": {token}}),
+	}
+	for name, rr := range cases {
+		if rr.Code != gohttp.StatusPageExpired {
+			t.Errorf("%s: got %d want 419", name, rr.Code)
+		}
+	}
+}
+
+func TestCSRF_Except(t *testing.T) {
+	h := csrfHandler(gohttp.NewCSRF("key").Except("webhooks/*"))
+
+	if rr := csrfPost(h, "/webhooks/stripe", nil, nil); rr.Code != http.StatusOK {
+		t.Errorf("excepted: got %d want 200", rr.Code)
+	}
+	if rr := csrfPost(h, "/profile", nil, nil); rr.Code != gohttp.StatusPageExpired {
+		t.Errorf("protected: got %d want 419", rr.Code)
+	}
+}
+
+func TestCSRF_NeedsKey(t *testing.T) {
+	c := gohttp.NewCSRF("key")
+	c.SetKey("")
+	token, cookies := csrfSession(t, csrfHandler(c))
+	if rr := csrfPost(csrfHandler(c), "/form", cookies, url.Values{"_token": {token}}); rr.Code != http.StatusOK {
+		t.Errorf("SetKey(\"\") should keep the key: got %d", rr.Code)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic wrapping a handler without a key")
+		}
+	}()
+	csrfHandler(gohttp.NewCSRF(""))
+}
+
+// ── Templates ─────────────────────────────────────────────────────────────────
+
+func TestCSRF_FieldTemplateFunc(t *testing.T) {
+	dir := t.TempDir()
+	form := `<form method="POST">{{ csrf_field }}</form>`
+	if err := os.WriteFile(filepath.Join(dir, "form.html"), []byte(form), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	views := gohttp.NewViewEngine(dir, ".html").
+		Funcs(gohttp.CSRFFuncs(nil)).
+		RequestFuncs(gohttp.CSRFFuncs)
+
+	h := gohttp.NewCSRF("key").Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		views.Request(r).View(w, "form", nil)
+	}))
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	want := `<input type="hidden" name="_token" value="`
+	if !strings.Contains(rr.Body.String(), want) {
+		t.Errorf("body %q does not contain %q", rr.Body.String(), want)
+	}
+
+	// Without Request(r) the helper refuses to render.
+	rr = httptest.NewRecorder()
+	views.View(rr, "form", nil)
+	if rr.Code != http.StatusInternalServerError {
+		t.Errorf("unbound render: got %d want 500", rr.Code)
+	}
+}
@@ -13,11 +13,13 @@
 //	}
 //	if err := req.Bind(&payload); err != nil { ... }
 //
-//	// Input retrieval (query string + POST body)
+//	// Input retrieval (query string + POST or JSON body)
 //	name  := req.Input("name", "default")
+//	city  := req.Input("user.address.city")
 //	page  := req.Query("page", "1")
-//	all   := req.All()          // map[string]string
+//	all   := req.All()          // map[string]string, nested keys dotted
 //	ok    := req.Has("name")
+//	per   := req.Integer("per_page", 15)
 //
 //	// Route params (requires Chi router)
 //	id := req.RouteParam("id")
@@ -54,6 +56,7 @@
 //	res.Unauthorized()            // 401 {"message": "Unauthenticated."}
 //	res.Forbidden()               // 403 {"message": "This action is unauthorized."}
 //	res.NotFound()                // 404 {"message": "Not found."}
+//	res.TooManyRequests()         // 429 {"message": "Too Many Attempts."}
 //	res.ServerError()             // 500 {"message": "Server Error."}
 //	res.ValidationError(errs)     // 422 {"errors": {"field": ["msg"]}}
 //
@@ -61,10 +64,34 @@
 //	res.RedirectTo("/dashboard")                // 302
 //	res.RedirectBack(r, "/fallback")            // 302 to Referer
 //	res.Redirect(http.StatusMovedPermanently, "/new") // custom code
+//	res.WithRequest(r).RedirectToRoute("users.show", map[string]any{"id": 1})
+//
+// # CORS
+//
+// CORS is global middleware driven by config.CORSConfig; it answers
+// preflight OPTIONS requests before routing.
+//
+//	cors := gohttp.NewCORS(cfg.CORS)
+//	router.Middleware(cors.Middleware)
+//
+// # CSRF
+//
+// CSRF verifies a per-session token on unsafe methods (419 on mismatch).
+//
+//	csrf := gohttp.NewCSRF(appKey).Except("webhooks/*")
+//	router.AliasMiddleware("csrf", csrf.Middleware)
+//	token := gohttp.CSRFToken(r)
 //
 // # ViewEngine
 //
 //	engine := gohttp.NewViewEngine("./views", ".html")
 //	engine.View(w, "home", map[string]any{"title": "Home"})
 //	engine.ViewWithLayout(w, "layouts/app", "home", data)
+//
+//	// Template helpers, e.g. {{ route "users.show" "id" 1 }}
+//	engine.Funcs(router.TemplateFuncs())
+//
+//	// Request-bound helpers, e.g. {{ csrf_field }}
+//	engine.RequestFuncs(gohttp.CSRFFuncs)
+//	engine.Request(r).View(w, "form", data)
 package http
\ No newline at end of file
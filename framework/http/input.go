@@ -0,0 +1,459 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ── Input ────────────────────────────────────────────────────────────────────
+
+// data returns the request input, parsed once: the query string, then the
+// form body, then a JSON object body, then Merge — later sources win. Form
+// keys in bracket notation nest like PHP: "user[name]", "tags[]".
+func (req *Request) data() map[string]any {
+	if req.input != nil {
+		return req.input
+	}
+	req.input = make(map[string]any)
+	for key, values := range req.raw.URL.Query() {
+		setForm(req.input, key, values)
+	}
+	if strings.Contains(req.ContentType(), "multipart/form-data") {
+		_ = req.raw.ParseMultipartForm(req.memory())
+	} else {
+		_ = req.raw.ParseForm()
+	}
+	for key, values := range req.raw.PostForm {
+		setForm(req.input, key, values)
+	}
+	if strings.Contains(req.ContentType(), "application/json") {
+		if body, err := req.readBody(); err == nil && len(body) > 0 {
+			var object map[string]any
+			dec := json.NewDecoder(bytes.NewReader(body))
+			dec.UseNumber()
+			if dec.Decode(&object) == nil {
+				for key, v := range object {
+					req.input[key] = v
+				}
+			}
+		}
+	}
+	return req.input
+}
+
+// setForm stores a form field, nesting bracket keys.
+func setForm(data map[string]any, key string, values []string) {
+	var v any = values[0]
+	if len(values) > 1 {
+		v = toList(values)
+	}
+	name, rest, ok := strings.Cut(key, "[")
+	if !ok || name == "" || !strings.HasSuffix(rest, "]") {
+		data[key] = v
+		return
+	}
+	path := append([]string{name}, strings.Split(strings.TrimSuffix(rest, "]"), "][")...)
+	if path[len(path)-1] == "" { // "tags[]"
+		path, v = path[:len(path)-1], toList(values)
+	}
+	node := data
+	for _, seg := range path[:len(path)-1] {
+		child, ok := node[seg].(map[string]any)
+		if !ok {
+			child = make(map[string]any)
+			node[seg] = child
+		}
+		node = child
+	}
+	node[path[len(path)-1]] = v
+}
+
+func toList(values []string) []any {
+	list := make([]any, len(values))
+	for i, s := range values {
+		list[i] = s
+	}
+	return list
+}
+
+// lookup finds key in the input: an exact key first, then a dot path such
+// as "user.address.city" or "items.0.sku". A "*" segment collects every
+// element: "items.*.sku".
+func (req *Request) lookup(key string) (any, bool) {
+	data := req.data()
+	if v, ok := data[key]; ok {
+		return v, true
+	}
+	return dig(data, strings.Split(key, "."))
+}
+
+func dig(v any, path []string) (any, bool) {
+	if len(path) == 0 {
+		return v, true
+	}
+	seg, rest := path[0], path[1:]
+	switch node := v.(type) {
+	case map[string]any:
+		if seg == "*" {
+			keys := make([]string, 0, len(node))
+			for k := range node {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			var out []any
+			for _, k := range keys {
+				if x, ok := dig(node[k], rest); ok {
+					out = append(out, x)
+				}
+			}
+			return out, true
+		}
+		child, ok := node[seg]
+		if !ok {
+			return nil, false
+		}
+		return dig(child, rest)
+	case []any:
+		if seg == "*" {
+			var out []any
+			for _, child := range node {
+				if x, ok := dig(child, rest); ok {
+					out = append(out, x)
+				}
+			}
+			return out, true
+		}
+		i, err := strconv.Atoi(seg)
+		if err != nil || i < 0 || i >= len(node) {
+			return nil, false
+		}
+		return dig(node[i], rest)
+	}
+	return nil, false
+}
+
+// stringify formats an input value; a list gives its first value, like
+// http.Request.FormValue, and an object its JSON.
+func stringify(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		if len(v) == 0 {
+			return ""
+		}
+		return stringify(v[0])
+	case map[string]any:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+	return fmt.Sprint(v)
+}
+
+// flatten adds v to out under dot keys: {"user": {"name": "Taylor"}}
+// becomes "user.name". A list is also kept under its own key with its
+// first value, as Input gives it, so "?roles=a&roles=b" has "roles" as
+// well as "roles.0" and "roles.1".
+func flatten(out map[string]string, key string, v any) {
+	join := func(k string) string {
+		if key == "" {
+			return k
+		}
+		return key + "." + k
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			flatten(out, join(k), child)
+		}
+	case []any:
+		if key != "" {
+			out[key] = stringify(v)
+		}
+		for i, child := range v {
+			flatten(out, join(strconv.Itoa(i)), child)
+		}
+	default:
+		out[key] = stringify(v)
+	}
+}
+
+// Input returns an input value from the query string, form or JSON body.
+// Nested values use dot notation.
+//
+//	// Laravel: $request->input('user.address.city', 'Paris')
+//	city := req.Input("user.address.city", "Paris")
+func (req *Request) Input(key string, fallback ...string) string {
+	v, _ := req.lookup(key)
+	s := stringify(v)
+	if s == "" && len(fallback) > 0 {
+		return fallback[0]
+	}
+	return s
+}
+
+// Query returns a query-string value.
+func (req *Request) Query(key string, fallback ...string) string {
+	v := req.raw.URL.Query().Get(key)
+	if v == "" && len(fallback) > 0 {
+		return fallback[0]
+	}
+	return v
+}
+
+// All returns all input as a flat map, nested values under dot keys
+// ("user.name", "tags.0") and lists under their own key too, ready for
+// validation.Make.
+//
+//	// Laravel: $request->all()
+//	v := validation.Make(req.All(), rules)
+func (req *Request) All() map[string]string {
+	out := make(map[string]string)
+	flatten(out, "", req.data())
+	return out
+}
+
+// Only returns the All entries for the given keys, nested ones included.
+//
+//	// Laravel: $request->only(['name', 'address'])
+//	req.Only("name", "address") // "name", "address.city", ...
+func (req *Request) Only(keys ...string) map[string]string {
+	out := make(map[string]string)
+	for k, v := range req.All() {
+		if underAny(k, keys) {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// Except returns the All entries but those for the given keys.
+//
+//	// Laravel: $request->except(['password'])
+func (req *Request) Except(keys ...string) map[string]string {
+	out := make(map[string]string)
+	for k, v := range req.All() {
+		if !underAny(k, keys) {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// underAny reports whether a dot key is one of keys or nested under one.
+func underAny(key string, keys []string) bool {
+	for _, k := range keys {
+		if key == k || strings.HasPrefix(key, k+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Merge adds input, replacing top-level keys. Nested values are
+// map[string]any and []any, as decoded from JSON. Bind still decodes the
+// body.
+//
+//	// Laravel: $request->merge(['votes' => 0])
+//	req.Merge(map[string]any{"votes": 0})
+func (req *Request) Merge(input map[string]any) {
+	data := req.data()
+	for k, v := range input {
+		data[k] = v
+	}
+}
+
+// Has returns true if the key is present and non-empty — see Filled.
+func (req *Request) Has(key string) bool {
+	return req.Filled(key)
+}
+
+// Filled reports whether every key is present and not empty: not "", null,
+// an empty list or an empty object.
+//
+//	// Laravel: $request->filled('name')
+func (req *Request) Filled(keys ...string) bool {
+	for _, key := range keys {
+		v, ok := req.lookup(key)
+		if !ok || blank(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Missing reports whether any key is absent. Empty values are present.
+//
+//	// Laravel: $request->missing('name')
+func (req *Request) Missing(keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := req.lookup(key); !ok {
+			return true
+		}
+	}
+	return false
+}
+
+func blank(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// WhenHas calls fn with the value when the key is present, otherwise the
+// optional otherwise.
+//
+//	// Laravel: $request->whenHas('name', fn ($name) => ...)
+//	req.WhenHas("name", func(name string) { user.Name = name })
+func (req *Request) WhenHas(key string, fn func(string), otherwise ...func()) {
+	if v, ok := req.lookup(key); ok {
+		fn(stringify(v))
+		return
+	}
+	for _, f := range otherwise {
+		f()
+	}
+}
+
+// ── Typed input ──────────────────────────────────────────────────────────────
+
+// Integer returns an input value as an int, or the fallback (default 0)
+// when it is missing, not a number or out of the int range. Decimals are
+// truncated.
+//
+//	// Laravel: $request->integer('per_page', 15)
+//	perPage := req.Integer("per_page", 15)
+func (req *Request) Integer(key string, fallback ...int) int {
+	s := strings.TrimSpace(req.Input(key))
+	if n, err := strconv.Atoi(s); err == nil {
+		return n
+	}
+	// NaN fails both comparisons; ±Inf and huge values fail one.
+	if f, err := strconv.ParseFloat(s, 64); err == nil && f >= math.MinInt && f < math.MaxInt {
+		return int(f)
+	}
+	return first(fallback, 0)
+}
+
+// Float returns an input value as a float64, or the fallback (default 0).
+//
+//	// Laravel: $request->float('price')
+func (req *Request) Float(key string, fallback ...float64) float64 {
+	if f, err := strconv.ParseFloat(strings.TrimSpace(req.Input(key)), 64); err == nil {
+		return f
+	}
+	return first(fallback, 0)
+}
+
+// Boolean returns true for "1", "true", "on" and "yes" (any case) or a
+// JSON true, false for other values, and the fallback (default false)
+// when the key is missing.
+//
+//	// Laravel: $request->boolean('archived')
+func (req *Request) Boolean(key string, fallback ...bool) bool {
+	v, ok := req.lookup(key)
+	if !ok {
+		return first(fallback, false)
+	}
+	switch strings.ToLower(strings.TrimSpace(stringify(v))) {
+	case "1", "true", "on", "yes":
+		return true
+	}
+	return false
+}
+
+// dateLayouts are tried in order by Date without a layout.
+var dateLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
+// Date parses an input value with the layout, or as RFC 3339,
+// "2006-01-02 15:04:05" or "2006-01-02". A missing or empty value gives
+// the zero time and no error.
+//
+//	// Laravel: $request->date('published_at')
+//	at, err := req.Date("published_at")
+//	birthday, err := req.Date("birthday", "02/01/2006")
+func (req *Request) Date(key string, layout ...string) (time.Time, error) {
+	s := strings.TrimSpace(req.Input(key))
+	if s == "" {
+		return time.Time{}, nil
+	}
+	layouts := dateLayouts
+	if len(layout) > 0 {
+		layouts = layout
+	}
+	var err error
+	for _, l := range layouts {
+		var t time.Time
+		if t, err = time.Parse(l, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("input %s: invalid date %q: %w", key, s, err)
+}
+
+// Collection returns an input list; an object gives its values ordered by
+// key, a single value a one-element list and a missing key nil.
+//
+//	// Laravel: $request->collect('users.*.email')
+//	for _, email := range req.Collection("users.*.email") { ... }
+func (req *Request) Collection(key string) []any {
+	v, ok := req.lookup(key)
+	if !ok || v == nil {
+		return nil
+	}
+	switch v := v.(type) {
+	case []any:
+		return append([]any(nil), v...)
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		out := make([]any, len(keys))
+		for i, k := range keys {
+			out[i] = v[k]
+		}
+		return out
+	}
+	return []any{v}
+}
+
+// Enum returns the input value when it is one of allowed, usually a
+// type's constants. Methods cannot take type parameters, hence a function.
+//
+//	// Laravel: $request->enum('status', Status::class)
+//	status, ok := gohttp.Enum(req, "status", StatusDraft, StatusPublished)
+func Enum[T ~string | ~int](req *Request, key string, allowed ...T) (T, bool) {
+	s := req.Input(key)
+	for _, v := range allowed {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.String && rv.String() == s ||
+			rv.Kind() != reflect.String && strconv.FormatInt(rv.Int(), 10) == s {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
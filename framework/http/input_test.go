@@ -0,0 +1,297 @@
+package http_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	gohttp "github.com/km-arc/go-laravel/http"
+	"github.com/km-arc/go-laravel/http/validation"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+const userJSON = `{
+	"name": "Taylor",
+	"age": 38,
+	"admin": true,
+	"bio": "",
+	"nickname": null,
+	"user": {"address": {"city": "Little Rock"}},
+	"tags": ["go", "php"],
+	"items": [{"sku": "A1", "qty": 2}, {"sku": "B2", "qty": 1}],
+	"big": 9007199254740993
+}`
+
+type status string
+
+const (
+	statusDraft     status = "draft"
+	statusPublished status = "published"
+)
+
+// ── Nested input ─────────────────────────────────────────────────────────────
+
+func TestInput_JSONDotNotation(t *testing.T) {
+	req := newJSONRequest(t, userJSON)
+	cases := map[string]string{
+		"name":              "Taylor",
+		"age":               "38",
+		"admin":             "true",
+		"user.address.city": "Little Rock",
+		"tags.1":            "php",
+		"items.0.sku":       "A1",
+		"items.*.sku":       "A1",
+		"big":               "9007199254740993",
+		"user.address.zip":  "",
+		"tags.9":            "",
+	}
+	for key, want := range cases {
+		if got := req.Input(key); got != want {
+			t.Errorf("Input(%q): got %q want %q", key, got, want)
+		}
+	}
+	if got := req.Input("user.address.zip", "72201"); got != "72201" {
+		t.Errorf("fallback: got %q", got)
+	}
+}
+
+func TestInput_JSONBodyIsCached(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/?page=2", strings.NewReader(`{"name":"Taylor"}`))
+	r.Header.Set("Content-Type", "application/json")
+	req := gohttp.NewRequest(r)
+
+	if got := req.Input("name"); got != "Taylor" {
+		t.Fatalf("Input: got %q", got)
+	}
+	var v struct{ Name string }
+	if err := req.Bind(&v); err != nil || v.Name != "Taylor" {
+		t.Errorf("Bind after Input: got %+v, %v", v, err)
+	}
+	if body, _ := io.ReadAll(r.Body); string(body) != `{"name":"Taylor"}` {
+		t.Errorf("raw body: got %q", body)
+	}
+	if got := req.Input("page"); got != "2" {
+		t.Errorf("query: got %q", got)
+	}
+}
+
+func TestInput_FormBrackets(t *testing.T) {
+	req := newFormRequest(t, url.Values{
+		"user[name]":  {"Abigail"},
+		"tags[]":      {"go", "php"},
+		"colors":      {"red", "blue"},
+		"plain.field": {"kept"},
+	})
+	if got := req.Input("user.name"); got != "Abigail" {
+		t.Errorf("user.name: got %q", got)
+	}
+	if got := req.Collection("tags"); len(got) != 2 || got[1] != "php" {
+		t.Errorf("tags: got %v", got)
+	}
+	if got := req.Input("colors"); got != "red" {
+		t.Errorf("repeated field: got %q want the first value", got)
+	}
+	if got := req.Input("plain.field"); got != "kept" {
+		t.Errorf("literal dot key: got %q", got)
+	}
+}
+
+func TestInput_All(t *testing.T) {
+	all := newJSONRequest(t, userJSON).All()
+	want := map[string]string{
+		"name":              "Taylor",
+		"user.address.city": "Little Rock",
+		"tags.0":            "go",
+		"items.1.qty":       "1",
+		"bio":               "",
+	}
+	for k, v := range want {
+		if got, ok := all[k]; !ok || got != v {
+			t.Errorf("All[%q]: got %q want %q", k, got, v)
+		}
+	}
+	if _, ok := all["user"]; ok {
+		t.Error("objects are flattened, not stringified")
+	}
+}
+
+func TestInput_AllKeepsRepeatedFields(t *testing.T) {
+	all := newGetRequest(t, "roles=admin&roles=editor").All()
+	want := map[string]string{"roles": "admin", "roles.0": "admin", "roles.1": "editor"}
+	for k, v := range want {
+		if got, ok := all[k]; !ok || got != v {
+			t.Errorf("All[%q]: got %q want %q", k, got, v)
+		}
+	}
+	v := validation.Make(all, validation.Rules{"roles": "required"})
+	if v.Fails() {
+		t.Errorf("required repeated field: %v", v.Errors())
+	}
+}
+
+// ── Only / Except / Merge ────────────────────────────────────────────────────
+
+func TestInput_OnlyExcept(t *testing.T) {
+	req := newJSONRequest(t, userJSON)
+
+	only := req.Only("name", "user")
+	if len(only) != 2 || only["name"] != "Taylor" || only["user.address.city"] != "Little Rock" {
+		t.Errorf("Only: got %v", only)
+	}
+	except := req.Except("items", "tags", "user")
+	if _, ok := except["items.0.sku"]; ok || except["name"] != "Taylor" {
+		t.Errorf("Except: got %v", except)
+	}
+}
+
+func TestInput_Merge(t *testing.T) {
+	req := newJSONRequest(t, `{"name":"Taylor","votes":3}`)
+	req.Merge(map[string]any{"votes": 0, "meta": map[string]any{"source": "api"}})
+
+	if got := req.Integer("votes", 99); got != 0 {
+		t.Errorf("votes: got %d want 0", got)
+	}
+	if got := req.Input("meta.source"); got != "api" {
+		t.Errorf("meta.source: got %q", got)
+	}
+	if got := req.Input("name"); got != "Taylor" {
+		t.Errorf("name: got %q", got)
+	}
+}
+
+// ── Presence ─────────────────────────────────────────────────────────────────
+
+func TestInput_FilledMissing(t *testing.T) {
+	req := newJSONRequest(t, userJSON)
+
+	if !req.Filled("name", "user.address.city", "tags") {
+		t.Error("Filled: want true")
+	}
+	for _, key := range []string{"bio", "nickname", "missing"} {
+		if req.Filled(key) || req.Has(key) {
+			t.Errorf("Filled(%q): want false", key)
+		}
+	}
+	if req.Missing("bio", "nickname") {
+		t.Error("empty values are present")
+	}
+	if !req.Missing("name", "missing") {
+		t.Error("Missing: want true when any key is absent")
+	}
+}
+
+func TestInput_WhenHas(t *testing.T) {
+	req := newJSONRequest(t, userJSON)
+
+	var name string
+	req.WhenHas("name", func(v string) { name = v })
+	if name != "Taylor" {
+		t.Errorf("got %q", name)
+	}
+	otherwise := false
+	req.WhenHas("missing", func(string) { t.Error("fn should not run") }, func() { otherwise = true })
+	if !otherwise {
+		t.Error("otherwise should run")
+	}
+}
+
+// ── Typed input ──────────────────────────────────────────────────────────────
+
+func TestInput_Numbers(t *testing.T) {
+	req := newGetRequest(t, "page=3&price=19.99&ratio=2.7&junk=abc")
+
+	if got := req.Integer("page"); got != 3 {
+		t.Errorf("Integer: got %d", got)
+	}
+	if got := req.Integer("ratio"); got != 2 {
+		t.Errorf("Integer truncates: got %d", got)
+	}
+	if got := req.Integer("junk", 15); got != 15 {
+		t.Errorf("Integer fallback: got %d", got)
+	}
+	for _, q := range []string{"n=NaN", "n=Inf", "n=-Inf", "n=1e300", "n=-1e300"} {
+		if got := newGetRequest(t, q).Integer("n", 15); got != 15 {
+			t.Errorf("Integer(%s): got %d want the fallback", q, got)
+		}
+	}
+	if got := req.Float("price"); got != 19.99 {
+		t.Errorf("Float: got %v", got)
+	}
+	if got := req.Float("missing", 1.5); got != 1.5 {
+		t.Errorf("Float fallback: got %v", got)
+	}
+	if got := newJSONRequest(t, userJSON).Integer("items.0.qty"); got != 2 {
+		t.Errorf("JSON Integer: got %d", got)
+	}
+}
+
+func TestInput_Boolean(t *testing.T) {
+	req := newGetRequest(t, "a=1&b=on&c=YES&d=false&e=nope")
+	for key, want := range map[string]bool{"a": true, "b": true, "c": true, "d": false, "e": false} {
+		if got := req.Boolean(key); got != want {
+			t.Errorf("Boolean(%q): got %v want %v", key, got, want)
+		}
+	}
+	if !req.Boolean("missing", true) {
+		t.Error("fallback: want true")
+	}
+	if !newJSONRequest(t, userJSON).Boolean("admin") {
+		t.Error("JSON true: want true")
+	}
+}
+
+func TestInput_Date(t *testing.T) {
+	req := newGetRequest(t, "day=2026-10-18&at=2026-10-18T09:30:00Z&uk=18/10/2026&bad=soon")
+
+	if got, err := req.Date("day"); err != nil || !got.Equal(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("date: got %v, %v", got, err)
+	}
+	if got, err := req.Date("at"); err != nil || got.Hour() != 9 {
+		t.Errorf("RFC 3339: got %v, %v", got, err)
+	}
+	if got, err := req.Date("uk", "02/01/2006"); err != nil || got.Month() != time.October {
+		t.Errorf("layout: got %v, %v", got, err)
+	}
+	if got, err := req.Date("missing"); err != nil || !got.IsZero() {
+		t.Errorf("missing: got %v, %v", got, err)
+	}
+	if _, err := req.Date("bad"); err == nil {
+		t.Error("bad: expected an error")
+	}
+}
+
+func TestInput_Enum(t *testing.T) {
+	req := newGetRequest(t, "status=published&other=archived")
+
+	if got, ok := gohttp.Enum(req, "status", statusDraft, statusPublished); !ok || got != statusPublished {
+		t.Errorf("got %q, %v", got, ok)
+	}
+	if _, ok := gohttp.Enum(req, "other", statusDraft, statusPublished); ok {
+		t.Error("archived is not allowed")
+	}
+	if got, ok := gohttp.Enum(newJSONRequest(t, userJSON), "age", 18, 38); !ok || got != 38 {
+		t.Errorf("int: got %d, %v", got, ok)
+	}
+}
+
+func TestInput_Collection(t *testing.T) {
+	req := newJSONRequest(t, userJSON)
+
+	if got := req.Collection("items.*.sku"); len(got) != 2 || got[0] != "A1" || got[1] != "B2" {
+		t.Errorf("wildcard: got %v", got)
+	}
+	if got := req.Collection("name"); len(got) != 1 || got[0] != "Taylor" {
+		t.Errorf("scalar: got %v", got)
+	}
+	if got := req.Collection("user.address"); len(got) != 1 || got[0] != "Little Rock" {
+		t.Errorf("object: got %v", got)
+	}
+	if got := req.Collection("missing"); got != nil {
+		t.Errorf("missing: got %v", got)
+	}
+}
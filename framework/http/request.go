@@ -1,6 +1,8 @@
 package http
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -16,6 +18,10 @@ const maxMemory = 32 << 20 // 32 MB
 // Request wraps *http.Request with Laravel-style helpers.
 type Request struct {
 	raw *http.Request
+
+	body  []byte         // read once by readBody
+	read  bool           // body has been read
+	input map[string]any // query, form and JSON input, see data
 }
 
 // NewRequest wraps a standard *http.Request.
@@ -26,6 +32,27 @@ func NewRequest(r *http.Request) *Request {
 // Raw returns the underlying *http.Request.
 func (req *Request) Raw() *http.Request { return req.raw }
 
+// bodyLimitKey carries the LimitBody limit in the request context.
+type bodyLimitKey struct{}
+
+// LimitBody caps the request body at n bytes with http.MaxBytesReader:
+// reading past the limit fails with *http.MaxBytesError. Bind, File and
+// Files then keep at most n bytes of a multipart form in memory rather
+// than 32 MB. The router applies it for Route.MaxBody.
+func LimitBody(w http.ResponseWriter, r *http.Request, n int64) *http.Request {
+	r = r.WithContext(context.WithValue(r.Context(), bodyLimitKey{}, n))
+	r.Body = http.MaxBytesReader(w, r.Body, n)
+	return r
+}
+
+// memory returns how much of a multipart form is kept in memory.
+func (req *Request) memory() int64 {
+	if n, ok := req.raw.Context().Value(bodyLimitKey{}).(int64); ok && n < maxMemory {
+		return n
+	}
+	return maxMemory
+}
+
 // ── Binding ──────────────────────────────────────────────────────────────────
 
 // Bind decodes the request body into v.
@@ -38,7 +65,7 @@ func (req *Request) Bind(v any) error {
 	case strings.Contains(ct, "application/json"):
 		return req.bindJSON(v)
 	case strings.Contains(ct, "multipart/form-data"):
-		if err := req.raw.ParseMultipartForm(maxMemory); err != nil {
+		if err := req.raw.ParseMultipartForm(req.memory()); err != nil {
 			return err
 		}
 		return bindForm(req.raw.MultipartForm.Value, v)
@@ -51,8 +78,7 @@ func (req *Request) Bind(v any) error {
 }
 
 func (req *Request) bindJSON(v any) error {
-	defer req.raw.Body.Close()
-	body, err := io.ReadAll(req.raw.Body)
+	body, err := req.readBody()
 	if err != nil {
 		return err
 	}
@@ -62,6 +88,22 @@ func (req *Request) bindJSON(v any) error {
 	return json.Unmarshal(body, v)
 }
 
+// readBody reads the body once. The raw request gets a fresh copy, so
+// other readers still see it.
+func (req *Request) readBody() ([]byte, error) {
+	if req.read {
+		return req.body, nil
+	}
+	defer req.raw.Body.Close()
+	body, err := io.ReadAll(req.raw.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.body, req.read = body, true
+	req.raw.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
 // bindForm maps form values onto a struct using `form:"field"` tags.
 func bindForm(values map[string][]string, v any) error {
 	// Use JSON round-trip: build map → marshal → unmarshal into struct
@@ -81,43 +123,7 @@ func bindForm(values map[string][]string, v any) error {
 	return json.Unmarshal(b, v)
 }
 
-// ── Input helpers ────────────────────────────────────────────────────────────
-
-// Input returns a single input value (query string OR post body).
-func (req *Request) Input(key string, fallback ...string) string {
-	_ = req.raw.ParseForm()
-	v := req.raw.FormValue(key)
-	if v == "" && len(fallback) > 0 {
-		return fallback[0]
-	}
-	return v
-}
-
-// Query returns a query-string value.
-func (req *Request) Query(key string, fallback ...string) string {
-	v := req.raw.URL.Query().Get(key)
-	if v == "" && len(fallback) > 0 {
-		return fallback[0]
-	}
-	return v
-}
-
-// All returns all input as a flat map (query + post).
-func (req *Request) All() map[string]string {
-	_ = req.raw.ParseForm()
-	out := make(map[string]string)
-	for k, v := range req.raw.Form {
-		if len(v) > 0 {
-			out[k] = v[0]
-		}
-	}
-	return out
-}
-
-// Has returns true if the key is present and non-empty.
-func (req *Request) Has(key string) bool {
-	return req.Input(key) != ""
-}
+// ── Request info ─────────────────────────────────────────────────────────────
 
 // RouteParam returns a URL route parameter (chi).
 func (req *Request) RouteParam(key string) string {
@@ -143,6 +149,20 @@ func (req *Request) IP() string {
 	return req.raw.RemoteAddr
 }
 
+// Scheme returns "https" for TLS or proxied-TLS requests (see
+// X-Forwarded-Proto), otherwise "http".
+//
+//	// Laravel: $request->getScheme()
+func Scheme(r *http.Request) string {
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		return proto
+	}
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
 // Method returns the HTTP method.
 func (req *Request) Method() string { return req.raw.Method }
 
@@ -164,7 +184,7 @@ func (req *Request) IsJSON() bool {
 
 // File returns an uploaded file by field name.
 func (req *Request) File(key string) (*multipart.FileHeader, error) {
-	if err := req.raw.ParseMultipartForm(maxMemory); err != nil {
+	if err := req.raw.ParseMultipartForm(req.memory()); err != nil {
 		return nil, err
 	}
 	_, fh, err := req.raw.FormFile(key)
@@ -173,7 +193,7 @@ func (req *Request) File(key string) (*multipart.FileHeader, error) {
 
 // Files returns all uploaded files for a field.
 func (req *Request) Files(key string) ([]*multipart.FileHeader, error) {
-	if err := req.raw.ParseMultipartForm(maxMemory); err != nil {
+	if err := req.raw.ParseMultipartForm(req.memory()); err != nil {
 		return nil, err
 	}
 	if req.raw.MultipartForm == nil {
@@ -2,6 +2,7 @@ package http_test
 
 import (
 	"bytes"
+	"errors"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
@@ -226,6 +227,20 @@ func TestRequest_Path(t *testing.T) {
 	}
 }
 
+func TestScheme(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if got := gohttp.Scheme(r); got != "http" {
+		t.Errorf("plain: got %q", got)
+	}
+	r.Header.Set("X-Forwarded-Proto", "https")
+	if got := gohttp.Scheme(r); got != "https" {
+		t.Errorf("proxied: got %q", got)
+	}
+	if got := gohttp.Scheme(httptest.NewRequest(http.MethodGet, "https://example.com/", nil)); got != "https" {
+		t.Errorf("TLS: got %q", got)
+	}
+}
+
 // ── Multipart file upload ─────────────────────────────────────────────────────
 
 func TestRequest_File(t *testing.T) {
@@ -250,3 +265,18 @@ func TestRequest_File(t *testing.T) {
 		t.Errorf("Filename: got %q want %q", fh.Filename, "avatar.png")
 	}
 }
+
+// ── LimitBody ────────────────────────────────────────────────────────────────
+
+func TestLimitBody(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"Taylor Otwell"}`))
+	r.Header.Set("Content-Type", "application/json")
+	req := gohttp.NewRequest(gohttp.LimitBody(httptest.NewRecorder(), r, 8))
+
+	var v struct{ Name string }
+	err := req.Bind(&v)
+	var tooLarge *http.MaxBytesError
+	if !errors.As(err, &tooLarge) || tooLarge.Limit != 8 {
+		t.Errorf("got %v want *http.MaxBytesError", err)
+	}
+}
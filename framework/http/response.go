@@ -1,10 +1,15 @@
 package http
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"html/template"
 	"net/http"
+	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/km-arc/go-laravel/http/validation"
 )
@@ -14,6 +19,7 @@ import (
 // Response wraps http.ResponseWriter with Laravel-style helpers.
 type Response struct {
 	w http.ResponseWriter
+	r *http.Request // optional, see WithRequest
 }
 
 // NewResponse wraps a ResponseWriter.
@@ -21,6 +27,16 @@ func NewResponse(w http.ResponseWriter) *Response {
 	return &Response{w: w}
 }
 
+// WithRequest ties the response to the request it answers, which Redirect
+// needs to resolve relative URLs and RedirectToRoute to find the router.
+// Typed route handlers receive a *Response that already has it.
+//
+//	res := gohttp.NewResponse(w).WithRequest(r)
+func (res *Response) WithRequest(r *http.Request) *Response {
+	res.r = r
+	return res
+}
+
 // Raw returns the underlying ResponseWriter.
 func (res *Response) Raw() http.ResponseWriter { return res.w }
 
@@ -75,6 +91,24 @@ func (res *Response) NotFound(message ...string) {
 	res.JSON(http.StatusNotFound, envelope{"message": msg})
 }
 
+// TooManyRequests sends 429. Set Retry-After before calling it.
+func (res *Response) TooManyRequests(message ...string) {
+	msg := first(message, "Too Many Attempts.")
+	res.JSON(http.StatusTooManyRequests, envelope{"message": msg})
+}
+
+// ContentTooLarge sends 413, e.g. for a body over Route.MaxBody.
+func (res *Response) ContentTooLarge(message ...string) {
+	msg := first(message, "Content Too Large.")
+	res.JSON(http.StatusRequestEntityTooLarge, envelope{"message": msg})
+}
+
+// ServiceUnavailable sends 503, e.g. for a request over Route.Timeout.
+func (res *Response) ServiceUnavailable(message ...string) {
+	msg := first(message, "Service Unavailable.")
+	res.JSON(http.StatusServiceUnavailable, envelope{"message": msg})
+}
+
 // ServerError sends 500.
 func (res *Response) ServerError(message ...string) {
 	msg := first(message, "Server Error.")
@@ -90,11 +124,54 @@ func (res *Response) ValidationError(errors *validation.Errors) {
 
 // ── Redirects ────────────────────────────────────────────────────────────────
 
-// Redirect performs an HTTP redirect.
+// Redirect performs an HTTP redirect. With the request (see WithRequest),
+// relative URLs such as "../login" are resolved against its path and GET
+// requests get a short HTML body, as with http.Redirect; without it, the
+// URL is sent as is.
 //
 //	res.Redirect(http.StatusFound, "/dashboard")
 func (res *Response) Redirect(status int, url string) {
-	http.Redirect(res.w, &http.Request{}, url, status)
+	if res.r != nil {
+		http.Redirect(res.w, res.r, url, status)
+		return
+	}
+	res.w.Header().Set("Location", url)
+	res.w.WriteHeader(status)
+}
+
+// RedirectToRoute performs a 302 redirect to a named route. It needs the
+// request (see WithRequest), served by a router that generates URLs (see
+// WithURLGenerator), and returns the URL generation error if any.
+//
+//	// Laravel: return redirect()->route('users.show', ['id' => 1]);
+//	return res.RedirectToRoute("users.show", map[string]any{"id": 1})
+func (res *Response) RedirectToRoute(name string, params map[string]any) error {
+	var urls URLGenerator
+	if res.r != nil {
+		urls, _ = res.r.Context().Value(urlGeneratorKey{}).(URLGenerator)
+	}
+	if urls == nil {
+		return fmt.Errorf("http: no URL generator to redirect to route %q — see Response.WithRequest", name)
+	}
+	url, err := urls.URL(name, params)
+	if err != nil {
+		return err
+	}
+	res.Redirect(http.StatusFound, url)
+	return nil
+}
+
+// URLGenerator builds URLs for named routes; *routing.Router is one.
+type URLGenerator interface {
+	URL(name string, params map[string]any) (string, error)
+}
+
+type urlGeneratorKey struct{}
+
+// WithURLGenerator returns r carrying urls, for RedirectToRoute. The router
+// adds itself to every request it serves.
+func WithURLGenerator(r *http.Request, urls URLGenerator) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), urlGeneratorKey{}, urls))
 }
 
 // RedirectTo performs a 302 redirect.
@@ -119,51 +196,130 @@ func (res *Response) RedirectBack(r *http.Request, fallback string) {
 type ViewEngine struct {
 	dir string
 	ext string
+
+	mu           sync.RWMutex
+	funcs        template.FuncMap
+	requestFuncs []func(*http.Request) template.FuncMap
 }
 
 // NewViewEngine creates a ViewEngine.
 // dir is the templates directory (e.g. "./views"), ext is the file extension (e.g. ".html").
 func NewViewEngine(dir, ext string) *ViewEngine {
-	return &ViewEngine{dir: dir, ext: ext}
+	return &ViewEngine{dir: dir, ext: ext, funcs: template.FuncMap{}}
+}
+
+// Funcs adds helpers available to every template, e.g. the router's
+// {{ route "users.show" "id" 1 }}. Later calls override earlier names.
+//
+//	engine.Funcs(router.TemplateFuncs())
+func (ve *ViewEngine) Funcs(funcs template.FuncMap) *ViewEngine {
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	for name, fn := range funcs {
+		ve.funcs[name] = fn
+	}
+	return ve
+}
+
+// RequestFuncs registers helpers that depend on the current request, such as
+// {{ csrf_field }}. Templates get them through Request; the functions must
+// also be registered with Funcs so templates parse without a request.
+//
+//	engine.RequestFuncs(gohttp.CSRFFuncs)
+func (ve *ViewEngine) RequestFuncs(fn func(*http.Request) template.FuncMap) *ViewEngine {
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	ve.requestFuncs = append(ve.requestFuncs, fn)
+	return ve
+}
+
+// Request returns an engine whose request helpers are bound to r.
+// Use it to render forms:
+//
+//	engine.Request(r).ViewWithLayout(w, "layouts/app", "users/edit", data)
+func (ve *ViewEngine) Request(r *http.Request) *ViewEngine {
+	ve.mu.RLock()
+	defer ve.mu.RUnlock()
+	bound := &ViewEngine{dir: ve.dir, ext: ve.ext, funcs: template.FuncMap{}}
+	for name, fn := range ve.funcs {
+		bound.funcs[name] = fn
+	}
+	for _, fn := range ve.requestFuncs {
+		for name, f := range fn(r) {
+			bound.funcs[name] = f
+		}
+	}
+	return bound
+}
+
+// parse parses template files with the registered helpers.
+func (ve *ViewEngine) parse(files ...string) (*template.Template, error) {
+	ve.mu.RLock()
+	defer ve.mu.RUnlock()
+	return template.New(filepath.Base(files[0])).Funcs(ve.funcs).ParseFiles(files...)
 }
 
 // View renders a template file with data.
 //
 //	engine.View(res.Raw(), "home", map[string]any{"title": "Home"})
 func (ve *ViewEngine) View(w http.ResponseWriter, name string, data any) {
+	ve.ViewWithStatus(w, http.StatusOK, name, data)
+}
+
+// ViewWithStatus renders a template file with the given status code.
+//
+//	// Laravel: response()->view('errors.404', $data, 404)
+//	engine.ViewWithStatus(w, http.StatusNotFound, "errors/404", data)
+func (ve *ViewEngine) ViewWithStatus(w http.ResponseWriter, status int, name string, data any) {
 	pattern := filepath.Join(ve.dir, name+ve.ext)
-	tmpl, err := template.ParseFiles(pattern)
+	tmpl, err := ve.parse(pattern)
 	if err != nil {
 		http.Error(w, "Template not found: "+name, http.StatusInternalServerError)
 		return
 	}
-	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := tmpl.Execute(w, data); err != nil {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
 		http.Error(w, "Template render error", http.StatusInternalServerError)
+		return
 	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = buf.WriteTo(w)
+}
+
+// Exists reports whether a template file exists — Laravel: View::exists().
+func (ve *ViewEngine) Exists(name string) bool {
+	info, err := os.Stat(filepath.Join(ve.dir, name+ve.ext))
+	return err == nil && !info.IsDir()
 }
 
 // ViewWithLayout renders a template with a base layout.
 func (ve *ViewEngine) ViewWithLayout(w http.ResponseWriter, layout, name string, data any) {
 	layoutPath := filepath.Join(ve.dir, layout+ve.ext)
 	viewPath := filepath.Join(ve.dir, name+ve.ext)
-	tmpl, err := template.ParseFiles(layoutPath, viewPath)
+	tmpl, err := ve.parse(layoutPath, viewPath)
 	if err != nil {
 		http.Error(w, "Template error: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
-	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := tmpl.ExecuteTemplate(w, filepath.Base(layoutPath), data); err != nil {
+	// Render to a buffer so a failing helper (e.g. csrf_field) yields a
+	// clean 500 instead of half a page.
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, filepath.Base(layoutPath), data); err != nil {
 		http.Error(w, "Render error: "+err.Error(), http.StatusInternalServerError)
+		return
 	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = buf.WriteTo(w)
 }
 
 // ── Helpers ──────────────────────────────────────────────────────────────────
 
 type envelope map[string]any
 
-func first(ss []string, fallback string) string {
-	if len(ss) > 0 && ss[0] != "" {
+func first[T comparable](ss []T, fallback T) T {
+	var zero T
+	if len(ss) > 0 && ss[0] != zero {
 		return ss[0]
 	}
 	return fallback
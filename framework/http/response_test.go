@@ -2,8 +2,13 @@ package http_test
 
 import (
 	"encoding/json"
+	"fmt"
+	"html/template"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	gohttp "github.com/km-arc/go-laravel/http"
@@ -147,6 +152,19 @@ func TestResponse_NotFound(t *testing.T) {
 	}
 }
 
+func TestResponse_TooManyRequests(t *testing.T) {
+	res, rr := newResponse(t)
+	res.TooManyRequests()
+
+	if rr.Code != http.StatusTooManyRequests {
+		t.Errorf("status: got %d want 429", rr.Code)
+	}
+	m := decodeJSON(t, rr)
+	if m["message"] != "Too Many Attempts." {
+		t.Errorf("message: got %v", m["message"])
+	}
+}
+
 func TestResponse_ServerError(t *testing.T) {
 	res, rr := newResponse(t)
 	res.ServerError()
@@ -156,6 +174,27 @@ func TestResponse_ServerError(t *testing.T) {
 	}
 }
 
+func TestResponse_ContentTooLarge(t *testing.T) {
+	res, rr := newResponse(t)
+	res.ContentTooLarge()
+
+	if rr.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status: got %d want 413", rr.Code)
+	}
+	if m := decodeJSON(t, rr); m["message"] != "Content Too Large." {
+		t.Errorf("message: got %v", m["message"])
+	}
+}
+
+func TestResponse_ServiceUnavailable(t *testing.T) {
+	res, rr := newResponse(t)
+	res.ServiceUnavailable()
+
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("status: got %d want 503", rr.Code)
+	}
+}
+
 // ── ValidationError ───────────────────────────────────────────────────────────
 
 func TestResponse_ValidationError(t *testing.T) {
@@ -197,6 +236,56 @@ func TestResponse_RedirectTo(t *testing.T) {
 	}
 }
 
+func TestResponse_Redirect_WithoutRequest(t *testing.T) {
+	res, rr := newResponse(t)
+	res.Redirect(http.StatusMovedPermanently, "new")
+
+	if rr.Code != http.StatusMovedPermanently {
+		t.Errorf("status: got %d want 301", rr.Code)
+	}
+	if loc := rr.Header().Get("Location"); loc != "new" {
+		t.Errorf("Location: got %q want new", loc)
+	}
+}
+
+func TestResponse_Redirect_RelativeToRequest(t *testing.T) {
+	rr := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/admin/users/1", nil)
+	gohttp.NewResponse(rr).WithRequest(r).Redirect(http.StatusFound, "../login")
+
+	if loc := rr.Header().Get("Location"); loc != "/admin/login" {
+		t.Errorf("Location: got %q want /admin/login", loc)
+	}
+}
+
+type stubURLs map[string]string
+
+func (u stubURLs) URL(name string, _ map[string]any) (string, error) {
+	if url, ok := u[name]; ok {
+		return url, nil
+	}
+	return "", fmt.Errorf("route %q not defined", name)
+}
+
+func TestResponse_RedirectToRoute(t *testing.T) {
+	rr := httptest.NewRecorder()
+	r := gohttp.WithURLGenerator(httptest.NewRequest(http.MethodGet, "/", nil), stubURLs{"home": "http://example.com/home"})
+	res := gohttp.NewResponse(rr).WithRequest(r)
+
+	if err := res.RedirectToRoute("home", nil); err != nil {
+		t.Fatal(err)
+	}
+	if rr.Code != http.StatusFound || rr.Header().Get("Location") != "http://example.com/home" {
+		t.Errorf("got %d %q", rr.Code, rr.Header().Get("Location"))
+	}
+	if err := res.RedirectToRoute("missing", nil); err == nil {
+		t.Error("expected an error for an unknown route")
+	}
+	if err := gohttp.NewResponse(httptest.NewRecorder()).RedirectToRoute("home", nil); err == nil {
+		t.Error("expected an error without a request")
+	}
+}
+
 func TestResponse_RedirectBack_WithReferer(t *testing.T) {
 	rr := httptest.NewRecorder()
 	res := gohttp.NewResponse(rr)
@@ -236,3 +325,46 @@ func TestResponse_Raw(t *testing.T) {
 		t.Error("Raw() should not be nil")
 	}
 }
+
+// ── ViewEngine ────────────────────────────────────────────────────────────────
+
+func TestViewEngine_Funcs(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "home.html"), []byte(`{{ shout .name }}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	engine := gohttp.NewViewEngine(dir, ".html").Funcs(template.FuncMap{
+		"shout": strings.ToUpper,
+	})
+
+	rr := httptest.NewRecorder()
+	engine.View(rr, "home", map[string]any{"name": "taylor"})
+
+	if rr.Body.String() != "TAYLOR" {
+		t.Errorf("got %q want %q", rr.Body.String(), "TAYLOR")
+	}
+}
+
+func TestViewEngine_ViewWithStatus(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "errors"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "errors", "404.html"), []byte(`missing {{ .path }}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	engine := gohttp.NewViewEngine(dir, ".html")
+
+	if !engine.Exists("errors/404") || engine.Exists("errors/500") || engine.Exists("errors") {
+		t.Error("Exists: wrong result")
+	}
+
+	rr := httptest.NewRecorder()
+	engine.ViewWithStatus(rr, http.StatusNotFound, "errors/404", map[string]any{"path": "/x"})
+	if rr.Code != http.StatusNotFound || rr.Body.String() != "missing /x" {
+		t.Errorf("got %d %q", rr.Code, rr.Body.String())
+	}
+	if ct := rr.Header().Get("Content-Type"); ct != "text/html; charset=utf-8" {
+		t.Errorf("Content-Type: got %q", ct)
+	}
+}
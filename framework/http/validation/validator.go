@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/mail"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -24,6 +25,20 @@ func (e *Errors) add(field, msg string) {
 	e.Bag[field] = append(e.Bag[field], msg)
 }
 
+// Error implements error with the first message, so handlers can return the
+// bag as an error (see routing's typed handlers, which answer 422).
+func (e *Errors) Error() string {
+	fields := make([]string, 0, len(e.Bag))
+	for field := range e.Bag {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	if len(fields) == 0 {
+		return "validation failed"
+	}
+	return e.First(fields[0])
+}
+
 // Has returns true if there are any errors.
 func (e *Errors) Has() bool { return len(e.Bag) > 0 }
 
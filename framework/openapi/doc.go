@@ -0,0 +1,30 @@
+// Package openapi generates an OpenAPI 3.1 document from the routes
+// registered on a routing.Router, so the API spec cannot drift from the code.
+//
+// # What Is Documented
+//
+// Every route outside a Domain group becomes an operation: its methods, path
+// parameters (with the patterns from Where / Pattern), name (as operationId)
+// and middleware-free URI come from the route registry. Domain routes are
+// left out because OpenAPI paths carry no host. Attach the rest to the route:
+//
+//	r.Post("/users", store).
+//	    Summary("Create a user").
+//	    Tags("users").
+//	    Rules(validation.Rules{"name": "required|min:2", "email": "required|email"}).
+//	    Returns(http.StatusCreated, &User{})
+//
+// Typed handlers returning (T, error) document a 200 response of T without
+// any annotation. Request and response types are converted to JSON Schema
+// using their json tags; named structs become components/schemas entries.
+// Validation rules become body properties (or query parameters for GET) with
+// type, format, length, range, enum and pattern derived from the rules.
+//
+// # Serving
+//
+//	// GET /openapi.json, plus a bundled docs page at /docs
+//	openapi.Register(r, openapi.Info{Title: "My API", Version: "1.0.0"}, "/docs")
+//
+// The docs page is self-contained — it needs no CDN — and lists every
+// operation with its parameters, body and responses.
+package openapi
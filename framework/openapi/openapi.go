@@ -0,0 +1,252 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/km-arc/go-laravel/framework/http/validation"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// Version is the OpenAPI version of generated documents.
+const Version = "3.1.0"
+
+// ── Document ─────────────────────────────────────────────────────────────────
+
+// Info is the document's info object.
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Document is an OpenAPI 3.1 document.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components *Components          `json:"components,omitempty"`
+}
+
+// Server is a base URL the API is served from.
+type Server struct {
+	URL string `json:"url"`
+}
+
+// PathItem holds the operations of one path, keyed by lower-case method.
+type PathItem map[string]*Operation
+
+// Operation documents one method on one path.
+type Operation struct {
+	OperationID string               `json:"operationId,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is a path or query parameter.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody documents the request payload.
+type RequestBody struct {
+	Required bool                 `json:"required,omitempty"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response documents one response status.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema for one content type.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the named schemas referenced with $ref.
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+// ── Generation ───────────────────────────────────────────────────────────────
+
+// Generate builds the document for every documented route on r. Routes
+// marked Undocumented are skipped; the router's base URL (see
+// Router.SetBaseURL) becomes the server when set.
+//
+// Routes in a Domain group are skipped too: OpenAPI keys paths by URI alone,
+// so the same URI on two hosts would overwrite one another.
+func Generate(r *routing.Router, info Info) *Document {
+	doc := &Document{OpenAPI: Version, Info: info, Paths: map[string]*PathItem{}}
+	if base := r.BaseURL(); base != "" {
+		doc.Servers = []Server{{URL: base}}
+	}
+	schemas := newRegistry()
+
+	for _, rt := range r.List(routing.RouteFilter{}) {
+		if rt.Doc.Hidden || rt.Domain != "" {
+			continue
+		}
+		path, params := pathTemplate(rt.Segments)
+		item := doc.Paths[path]
+		if item == nil {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		for _, method := range rt.Methods {
+			(*item)[strings.ToLower(method)] = operation(rt, method, params, schemas)
+		}
+	}
+
+	if len(schemas.named) > 0 {
+		doc.Components = &Components{Schemas: schemas.named}
+	}
+	return doc
+}
+
+func operation(rt routing.RouteInfo, method string, params []Parameter, schemas *registry) *Operation {
+	op := &Operation{
+		OperationID: operationID(rt, method),
+		Summary:     rt.Doc.Summary,
+		Description: rt.Doc.Description,
+		Tags:        rt.Doc.Tags,
+		Parameters:  slices.Clone(params),
+		Responses:   map[string]*Response{},
+	}
+
+	inQuery := method == http.MethodGet || method == http.MethodHead || method == http.MethodDelete
+	switch {
+	case rt.Doc.Request != nil && !inQuery:
+		op.RequestBody = &RequestBody{Required: true, Content: jsonContent(schemas.schema(rt.Doc.Request))}
+	case len(rt.Doc.Rules) > 0 && inQuery:
+		op.Parameters = append(op.Parameters, queryParameters(rt.Doc.Rules)...)
+	case len(rt.Doc.Rules) > 0:
+		op.RequestBody = &RequestBody{Required: true, Content: jsonContent(RulesSchema(rt.Doc.Rules))}
+	}
+
+	for status, t := range rt.Doc.Responses {
+		res := &Response{Description: statusText(status)}
+		if t != nil {
+			res.Content = jsonContent(schemas.schema(t))
+		}
+		op.Responses[strconv.Itoa(status)] = res
+	}
+	if len(rt.Doc.Rules) > 0 {
+		if _, ok := op.Responses["422"]; !ok {
+			op.Responses["422"] = &Response{
+				Description: "Validation failed",
+				Content:     jsonContent(validationErrorSchema()),
+			}
+		}
+	}
+	if len(op.Responses) == 0 || !hasSuccess(op.Responses) {
+		op.Responses["200"] = &Response{Description: "OK"}
+	}
+	return op
+}
+
+// pathTemplate turns a route's segments into an OpenAPI path template and
+// its path parameters: "/users/{id:(?:[0-9]+)}" → "/users/{id}" with
+// pattern "^(?:[0-9]+)$"; a trailing "*" becomes "{path}".
+func pathTemplate(segments []routing.PathSegment) (string, []Parameter) {
+	var b strings.Builder
+	var params []Parameter
+	for _, seg := range segments {
+		name := seg.Param
+		switch name {
+		case "":
+			b.WriteString(seg.Literal)
+			continue
+		case "*":
+			name = "path"
+		}
+		schema := &Schema{Type: "string"}
+		if seg.Regex != "" {
+			schema.Pattern = "^" + seg.Regex + "$"
+		}
+		params = append(params, Parameter{Name: name, In: "path", Required: true, Schema: schema})
+		b.WriteString("{" + name + "}")
+	}
+	return b.String(), params
+}
+
+func queryParameters(rules validation.Rules) []Parameter {
+	schema := RulesSchema(rules)
+	params := make([]Parameter, 0, len(schema.Properties))
+	for _, name := range sortedKeys(schema.Properties) {
+		params = append(params, Parameter{
+			Name:     name,
+			In:       "query",
+			Required: slices.Contains(schema.Required, name),
+			Schema:   schema.Properties[name],
+		})
+	}
+	return params
+}
+
+// operationID uses the route name, suffixed with the method when the route
+// answers several; unnamed routes get "get /users/{id}".
+func operationID(rt routing.RouteInfo, method string) string {
+	if rt.Name == "" {
+		return strings.ToLower(method) + " " + rt.URI
+	}
+	if len(rt.Methods) > 1 {
+		return rt.Name + "." + strings.ToLower(method)
+	}
+	return rt.Name
+}
+
+func jsonContent(s *Schema) map[string]MediaType {
+	return map[string]MediaType{"application/json": {Schema: s}}
+}
+
+func validationErrorSchema() *Schema {
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"errors": {
+				Type:                 "object",
+				AdditionalProperties: &Schema{Type: "array", Items: &Schema{Type: "string"}},
+			},
+		},
+	}
+}
+
+func hasSuccess(responses map[string]*Response) bool {
+	for status := range responses {
+		if strings.HasPrefix(status, "2") || strings.HasPrefix(status, "3") {
+			return true
+		}
+	}
+	return false
+}
+
+func statusText(status int) string {
+	if text := http.StatusText(status); text != "" {
+		return text
+	}
+	return fmt.Sprintf("Status %d", status)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,125 @@
+package openapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/http/validation"
+	"github.com/km-arc/go-laravel/framework/openapi"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+type User struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+func ok(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+
+func apiRouter() *routing.Router {
+	r := routing.New()
+	r.SetBaseURL("https://api.example.com")
+	r.Get("/users/{id}", func() (*User, error) { return &User{}, nil }).
+		Name("users.show").Summary("Show a user").Tags("users").WhereNumber("id")
+	r.Post("/users", ok).Name("users.store").
+		Rules(validation.Rules{"name": "required|min:2", "email": "required|email"}).
+		Returns(http.StatusCreated, &User{})
+	r.Get("/users", ok).Name("users.index").Rules(validation.Rules{"page": "integer"})
+	r.Get("/internal", ok).Undocumented()
+	return r
+}
+
+// ── Generate ──────────────────────────────────────────────────────────────────
+
+func TestGenerate_PathsAndOperations(t *testing.T) {
+	doc := openapi.Generate(apiRouter(), openapi.Info{Title: "API", Version: "1.0.0"})
+
+	if doc.OpenAPI != "3.1.0" || doc.Info.Title != "API" {
+		t.Errorf("header: got %s %+v", doc.OpenAPI, doc.Info)
+	}
+	if len(doc.Servers) != 1 || doc.Servers[0].URL != "https://api.example.com" {
+		t.Errorf("servers: got %+v", doc.Servers)
+	}
+	if _, ok := doc.Paths["/internal"]; ok {
+		t.Error("undocumented route was included")
+	}
+
+	show := (*doc.Paths["/users/{id}"])["get"]
+	if show == nil {
+		t.Fatalf("missing GET /users/{id}: %v", doc.Paths)
+	}
+	if show.OperationID != "users.show" || show.Summary != "Show a user" || show.Tags[0] != "users" {
+		t.Errorf("operation: got %+v", show)
+	}
+	p := show.Parameters[0]
+	if p.Name != "id" || p.In != "path" || !p.Required || p.Schema.Pattern != "^(?:[0-9]+)$" {
+		t.Errorf("path param: got %+v %+v", p, p.Schema)
+	}
+	res := show.Responses["200"]
+	if res == nil || res.Content["application/json"].Schema.Ref != "#/components/schemas/User" {
+		t.Errorf("typed handler response: got %+v", res)
+	}
+	if doc.Components == nil || doc.Components.Schemas["User"] == nil {
+		t.Fatalf("User schema not in components")
+	}
+}
+
+func TestGenerate_RulesBecomeBodyOrQuery(t *testing.T) {
+	doc := openapi.Generate(apiRouter(), openapi.Info{})
+	users := *doc.Paths["/users"]
+
+	store := users["post"]
+	if store.RequestBody == nil {
+		t.Fatal("POST with rules has no request body")
+	}
+	body := store.RequestBody.Content["application/json"].Schema
+	if body.Properties["email"].Format != "email" || len(body.Required) != 2 {
+		t.Errorf("body schema: got %+v", body)
+	}
+	if store.Responses["201"] == nil || store.Responses["422"] == nil {
+		t.Errorf("responses: got %v", store.Responses)
+	}
+
+	index := users["get"]
+	if index.RequestBody != nil || len(index.Parameters) != 1 {
+		t.Fatalf("GET rules should be query params: %+v", index)
+	}
+	if q := index.Parameters[0]; q.Name != "page" || q.In != "query" || q.Required || q.Schema.Type != "integer" {
+		t.Errorf("query param: got %+v", q)
+	}
+}
+
+func TestGenerate_DefaultsAndWildcards(t *testing.T) {
+	r := routing.New()
+	r.Match([]string{"GET", "POST"}, "/files/*", ok).Name("files")
+	doc := openapi.Generate(r, openapi.Info{})
+
+	item := doc.Paths["/files/{path}"]
+	if item == nil {
+		t.Fatalf("wildcard path: got %v", doc.Paths)
+	}
+	get := (*item)["get"]
+	if get.OperationID != "files.get" || get.Responses["200"] == nil || get.Parameters[0].Name != "path" {
+		t.Errorf("got %+v", get)
+	}
+	if doc.Servers != nil || doc.Components != nil {
+		t.Errorf("empty servers/components should be omitted: %+v", doc)
+	}
+}
+
+func TestGenerate_SkipsDomainRoutes(t *testing.T) {
+	r := routing.New()
+	r.Get("/status", ok).Name("status")
+	r.Domain("{account}.example.com", func(tenant *routing.Router) {
+		tenant.Get("/status", ok).Name("tenant.status")
+	})
+	doc := openapi.Generate(r, openapi.Info{})
+
+	get := (*doc.Paths["/status"])["get"]
+	if get == nil || get.OperationID != "status" {
+		t.Errorf("domain route overwrote /status: got %+v", get)
+	}
+}
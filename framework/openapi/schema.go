@@ -0,0 +1,260 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/http/validation"
+)
+
+// ── Schema ───────────────────────────────────────────────────────────────────
+
+// Schema is the JSON Schema subset used by generated documents. Type is a
+// string, or []string such as ["string", "null"] for nullable values.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 any                `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64           `json:"exclusiveMaximum,omitempty"`
+}
+
+// ── Go types ─────────────────────────────────────────────────────────────────
+
+var (
+	timeType      = reflect.TypeFor[time.Time]()
+	marshalerType = reflect.TypeFor[json.Marshaler]()
+)
+
+// registry collects named struct schemas into components.
+type registry struct {
+	named map[string]*Schema
+	names map[reflect.Type]string
+}
+
+func newRegistry() *registry {
+	return &registry{named: map[string]*Schema{}, names: map[reflect.Type]string{}}
+}
+
+// schema converts t following encoding/json: exported fields by json tag,
+// embedded structs flattened, omitempty fields optional. Named structs are
+// emitted once under components/schemas and referenced with $ref.
+func (reg *registry) schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Implements(marshalerType) || reflect.PointerTo(t).Implements(marshalerType):
+		return &Schema{} // custom encoding: any JSON value
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: reg.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: reg.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return reg.object(t)
+		}
+		return reg.ref(t)
+	}
+	return &Schema{}
+}
+
+func (reg *registry) ref(t reflect.Type) *Schema {
+	name, ok := reg.names[t]
+	if !ok {
+		base := componentName(t)
+		name = base
+		for i := 2; reg.named[name] != nil; i++ {
+			name = base + strconv.Itoa(i) // same name in another package
+		}
+		reg.names[t] = name
+		reg.named[name] = &Schema{} // placeholder for recursive types
+		*reg.named[name] = *reg.object(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// typeArgPackage matches the package path qualifying a type argument, as in
+// the "example.com/app." of "Page[example.com/app.User]".
+var typeArgPackage = regexp.MustCompile(`[^\[\],]*\.`)
+
+// invalidComponentRunes matches what a components key may not contain.
+var invalidComponentRunes = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// componentName is t's name as a components key: type arguments of generic
+// types lose their package path, so Page[example.com/app.User] is "Page_User".
+func componentName(t reflect.Type) string {
+	name := typeArgPackage.ReplaceAllString(t.Name(), "")
+	return strings.Trim(invalidComponentRunes.ReplaceAllString(name, "_"), "_")
+}
+
+func (reg *registry) object(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	reg.fields(t, s)
+	return s
+}
+
+func (reg *registry) fields(t reflect.Type, s *Schema) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				reg.fields(ft, s)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		s.Properties[name] = reg.schema(f.Type)
+		if !strings.Contains(opts, "omitempty") && !strings.Contains(opts, "omitzero") && f.Type.Kind() != reflect.Pointer {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// ── Validation rules ─────────────────────────────────────────────────────────
+
+// RulesSchema describes the input accepted by validation rules as an object
+// schema: "required" fields are required, "integer" / "numeric" / "boolean"
+// set the type, "email" and "url" the format, and min, max, size, between,
+// gt, gte, lt, lte, in, regex, alpha, alpha_num and alpha_dash add
+// constraints.
+//
+//	openapi.RulesSchema(validation.Rules{"age": "required|integer|gte:18"})
+//	// {"type":"object","properties":{"age":{"type":"integer","minimum":18}},"required":["age"]}
+func RulesSchema(rules validation.Rules) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, field := range sortedKeys(rules) {
+		prop, required := ruleSchema(rules[field])
+		s.Properties[field] = prop
+		if required {
+			s.Required = append(s.Required, field)
+		}
+	}
+	return s
+}
+
+func ruleSchema(spec string) (*Schema, bool) {
+	s := &Schema{Type: "string"}
+	var required, nullable bool
+
+	for _, rule := range strings.Split(spec, "|") {
+		name, param, _ := strings.Cut(strings.TrimSpace(rule), ":")
+		switch name {
+		case "required":
+			required = true
+		case "nullable":
+			nullable = true
+		case "integer":
+			s.Type = "integer"
+		case "numeric":
+			s.Type = "number"
+		case "boolean":
+			s.Type = "boolean"
+		case "email":
+			s.Format = "email"
+		case "url":
+			s.Format = "uri"
+		case "alpha":
+			s.Pattern = "^[a-zA-Z]+$"
+		case "alpha_num":
+			s.Pattern = "^[a-zA-Z0-9]+$"
+		case "alpha_dash":
+			s.Pattern = "^[a-zA-Z0-9_-]+$"
+		case "regex":
+			s.Pattern = strings.Trim(param, "/")
+		case "in":
+			for _, v := range strings.Split(param, ",") {
+				s.Enum = append(s.Enum, strings.TrimSpace(v))
+			}
+		case "min", "max", "size", "between", "gt", "gte", "lt", "lte":
+			applyBound(s, name, param)
+		}
+	}
+	if nullable {
+		s.Type = []string{s.Type.(string), "null"}
+	}
+	return s, required
+}
+
+// applyBound mirrors the validator: min, max, size and between limit the
+// length in characters, gt, gte, lt and lte the numeric value.
+func applyBound(s *Schema, rule, param string) {
+	lo, hi, _ := strings.Cut(param, ",")
+	length := func(v string) *int {
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil
+		}
+		return &n
+	}
+	number := func(v string) *float64 {
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil
+		}
+		return &f
+	}
+	switch rule {
+	case "min":
+		s.MinLength = length(lo)
+	case "max":
+		s.MaxLength = length(lo)
+	case "size":
+		s.MinLength, s.MaxLength = length(lo), length(lo)
+	case "between":
+		s.MinLength, s.MaxLength = length(lo), length(hi)
+	case "gt":
+		s.ExclusiveMinimum = number(lo)
+	case "gte":
+		s.Minimum = number(lo)
+	case "lt":
+		s.ExclusiveMaximum = number(lo)
+	case "lte":
+		s.Maximum = number(lo)
+	}
+}
@@ -0,0 +1,115 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/http/validation"
+	"github.com/km-arc/go-laravel/framework/openapi"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── Go types ──────────────────────────────────────────────────────────────────
+
+type Timestamps struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Post struct {
+	Timestamps
+	Title    string         `json:"title"`
+	Tags     []string       `json:"tags,omitempty"`
+	Meta     map[string]int `json:"meta"`
+	Author   *User          `json:"author"`
+	Replies  []Post         `json:"replies"`
+	Secret   string         `json:"-"`
+	internal string
+}
+
+func TestSchema_StructFields(t *testing.T) {
+	r := routing.New()
+	r.Post("/posts", ok).Accepts(Post{})
+	doc := openapi.Generate(r, openapi.Info{})
+
+	post := doc.Components.Schemas["Post"]
+	if post == nil {
+		t.Fatalf("Post not in components: %v", doc.Components.Schemas)
+	}
+	props := post.Properties
+	for _, name := range []string{"created_at", "title", "tags", "meta", "author", "replies"} {
+		if props[name] == nil {
+			t.Errorf("missing property %q", name)
+		}
+	}
+	if props["Secret"] != nil || props["internal"] != nil || props["-"] != nil {
+		t.Error("skipped fields were documented")
+	}
+	if props["created_at"].Format != "date-time" || props["meta"].AdditionalProperties.Type != "integer" {
+		t.Errorf("got %+v %+v", props["created_at"], props["meta"])
+	}
+	if props["replies"].Items.Ref != "#/components/schemas/Post" {
+		t.Errorf("recursive type: got %+v", props["replies"].Items)
+	}
+	if got := strings.Join(post.Required, ","); got != "created_at,title,meta,replies" {
+		t.Errorf("required: got %s", got)
+	}
+}
+
+type Page[T any] struct {
+	Items []T `json:"items"`
+}
+
+func TestSchema_GenericComponentNames(t *testing.T) {
+	r := routing.New()
+	r.Get("/users", func() (Page[User], error) { return Page[User]{}, nil })
+	doc := openapi.Generate(r, openapi.Info{})
+
+	res := (*doc.Paths["/users"])["get"].Responses["200"]
+	if ref := res.Content["application/json"].Schema.Ref; ref != "#/components/schemas/Page_User" {
+		t.Errorf("ref: got %s", ref)
+	}
+	if doc.Components.Schemas["Page_User"] == nil {
+		t.Errorf("components: got %v", doc.Components.Schemas)
+	}
+}
+
+// ── Validation rules ──────────────────────────────────────────────────────────
+
+func TestRulesSchema(t *testing.T) {
+	s := openapi.RulesSchema(validation.Rules{
+		"name":   "required|string|between:2,50",
+		"age":    "integer|gte:18|lt:130",
+		"role":   "required|in:admin,editor",
+		"site":   "nullable|url",
+		"code":   "regex:/^[A-Z]{3}$/",
+		"active": "boolean",
+	})
+	b, _ := json.Marshal(s)
+	got := string(b)
+	for _, want := range []string{
+		`"name":{"type":"string","minLength":2,"maxLength":50}`,
+		`"age":{"type":"integer","minimum":18,"exclusiveMaximum":130}`,
+		`"role":{"type":"string","enum":["admin","editor"]}`,
+		`"site":{"type":["string","null"],"format":"uri"}`,
+		`"code":{"type":"string","pattern":"^[A-Z]{3}$"}`,
+		`"active":{"type":"boolean"}`,
+		`"required":["name","role"]`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %s in\n%s", want, got)
+		}
+	}
+}
+
+func TestSchema_MarshalsAsJSON(t *testing.T) {
+	doc := openapi.Generate(apiRouter(), openapi.Info{Title: "API", Version: "1"})
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"openapi":"3.1.0"`) {
+		t.Errorf("got %s", b)
+	}
+}
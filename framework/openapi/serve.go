@@ -0,0 +1,55 @@
+package openapi
+
+import (
+	_ "embed"
+	"html/template"
+	"net/http"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── Serving ──────────────────────────────────────────────────────────────────
+
+// Register serves the document at GET /openapi.json (route "openapi.json")
+// and, when docsPath is not empty, the bundled docs page there (route
+// "openapi.docs"). Both routes are left out of the document. The handlers
+// are registered under the route names, so the routes can be cached; when
+// routes were loaded from the cache, only the handlers are registered.
+//
+//	openapi.Register(r, openapi.Info{Title: "My API", Version: "1.0.0"}, "/docs")
+func Register(r *routing.Router, info Info, docsPath string) {
+	r.RegisterHandler("openapi.json", Handler(r, info))
+	r.RegisterHandler("openapi.docs", DocsHandler("/openapi.json", info.Title))
+	if r.Cached() {
+		return
+	}
+	r.Get("/openapi.json", "openapi.json").Name("openapi.json").Undocumented()
+	if docsPath != "" {
+		r.Get(docsPath, "openapi.docs").Name("openapi.docs").Undocumented()
+	}
+}
+
+// Handler serves the document for r as JSON. It is generated on every
+// request, so routes registered later are included.
+func Handler(r *routing.Router, info Info) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		gohttp.NewResponse(w).JSON(http.StatusOK, Generate(r, info))
+	}
+}
+
+//go:embed docs.html
+var docsHTML string
+
+var docsTemplate = template.Must(template.New("docs").Parse(docsHTML))
+
+// DocsHandler serves a self-contained page rendering the document at specURL.
+func DocsHandler(specURL, title string) http.HandlerFunc {
+	if title == "" {
+		title = "API Documentation"
+	}
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = docsTemplate.Execute(w, map[string]string{"SpecURL": specURL, "Title": title})
+	}
+}
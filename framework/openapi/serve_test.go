@@ -0,0 +1,49 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/openapi"
+)
+
+// ── Register ──────────────────────────────────────────────────────────────────
+
+func TestRegister_ServesSpecAndDocs(t *testing.T) {
+	r := apiRouter()
+	openapi.Register(r, openapi.Info{Title: "Shop API", Version: "2.0.0"}, "/docs")
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if rr.Code != http.StatusOK || rr.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("spec: got %d %s", rr.Code, rr.Header().Get("Content-Type"))
+	}
+	var doc openapi.Document
+	if err := json.Unmarshal(rr.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Info.Title != "Shop API" || doc.Paths["/users/{id}"] == nil {
+		t.Errorf("got %+v", doc)
+	}
+	if doc.Paths["/openapi.json"] != nil || doc.Paths["/docs"] != nil {
+		t.Error("spec and docs routes should not document themselves")
+	}
+
+	rr = httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	body := rr.Body.String()
+	if rr.Code != http.StatusOK || !strings.Contains(body, "<title>Shop API</title>") || !strings.Contains(body, `"/openapi.json"`) {
+		t.Errorf("docs: got %d\n%s", rr.Code, body)
+	}
+}
+
+func TestRegister_WithoutDocs(t *testing.T) {
+	r := apiRouter()
+	openapi.Register(r, openapi.Info{}, "")
+	if r.HasRoute("openapi.docs") || !r.HasRoute("openapi.json") {
+		t.Error("docs page should be optional")
+	}
+}
@@ -1,9 +1,13 @@
 package providers
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/km-arc/go-laravel/framework/config"
 	"github.com/km-arc/go-laravel/framework/container"
 	gohttp "github.com/km-arc/go-laravel/framework/http"
+	"github.com/km-arc/go-laravel/framework/ratelimit"
 	"github.com/km-arc/go-laravel/framework/routing"
 )
 
@@ -15,6 +19,14 @@ import (
 // Bound abstracts:
 //   - "config"  → *config.Config
 //   - "app"     → *config.AppConfig  (alias shorthand)
+//   - "config.watcher" → *config.Watcher  (only when watching is enabled)
+//
+// If a config.SecretProvider is bound as "secrets", it resolves secret://
+// references in env values when the config is loaded.
+//
+// Live reload is opt-in: set Watch (or CONFIG_WATCH=true in .env) and every
+// change to the env files republishes a fresh Config via Instance("config"),
+// firing any Rebinding("config", ...) callbacks.
 //
 // Laravel equivalent:
 //
@@ -22,23 +34,58 @@ import (
 //	$app->singleton('config', fn() => new Repository($items));
 type ConfigServiceProvider struct {
 	container.BaseProvider
-	EnvFiles []string
+	EnvFiles      []string
+	Watch         bool          // reload config when env files change
+	WatchInterval time.Duration // poll interval, default: config.DefaultWatchInterval
 }
 
 func (p *ConfigServiceProvider) Register(app *container.Container) {
 	envFiles := p.EnvFiles
 	app.Singleton("config", func(c *container.Container) any {
+		if c.Bound("secrets") {
+			config.SetSecretProvider(container.Resolve[config.SecretProvider](c, "secrets"))
+		}
 		return config.Load(envFiles...)
 	})
 	app.Alias("config", "configuration")
 }
 
+func (p *ConfigServiceProvider) Boot(app *container.Container) {
+	// Resolve first so CONFIG_WATCH from the .env file itself is visible.
+	container.Resolve[*config.Config](app, "config")
+	if !p.Watch && !config.GetBool("CONFIG_WATCH", false) {
+		return
+	}
+	watcher := config.Watch(p.EnvFiles, p.WatchInterval, func(cfg *config.Config) {
+		app.Instance("config", cfg)
+	})
+	app.Instance("config.watcher", watcher)
+}
+
 // ── RoutingServiceProvider ────────────────────────────────────────────────────
 
 // RoutingServiceProvider registers the HTTP router.
 //
 // Bound abstracts:
 //   - "router"  → *routing.Router
+//   - "limiter" → *ratelimit.RateLimiter  (in-memory; rebind for a shared store)
+//   - "cors"    → *gohttp.CORS            (global middleware, from config CORS)
+//   - "csrf"    → *gohttp.CSRF            (signed with APP_KEY)
+//
+// Boot installs the CORS middleware on the root router, registers the
+// "csrf" and "throttle" middleware aliases, defines an "api" rate limiter
+// (60 requests per minute per IP) unless one exists, and fills the default
+// middleware groups: "web" gets "csrf", "api" gets "throttle:api".
+//
+// Typed handlers resolve their extra parameters from this container (see
+// routing.Router.Match).
+//
+// The router holds the middleware registry; register aliases and groups from
+// a provider's Boot:
+//
+//	router := container.Resolve[*routing.Router](app, "router")
+//	router.AliasMiddleware("auth", auth.Middleware)
+//	router.AppendMiddlewareToGroup("web", "auth")
 //
 // Laravel equivalent:
 //
@@ -50,10 +97,54 @@ type RoutingServiceProvider struct {
 
 func (p *RoutingServiceProvider) Register(app *container.Container) {
 	app.Singleton("router", func(c *container.Container) any {
-		return routing.New()
+		router := routing.New()
+		router.SetContainer(c)
+		return router
+	})
+	app.Singleton("limiter", func(c *container.Container) any {
+		return ratelimit.New(nil)
+	})
+	app.Singleton("cors", func(c *container.Container) any {
+		return gohttp.NewCORS(container.Resolve[*config.Config](c, "config").CORS)
+	})
+	app.Singleton("csrf", func(c *container.Container) any {
+		return gohttp.NewCSRF(container.Resolve[*config.Config](c, "config").App.Key.Reveal())
 	})
 }
 
+// Boot points URL generation at APP_URL, signs URLs and CSRF tokens with
+// APP_KEY and applies the CORS config, keeping them in sync on config
+// reload, then wires up the middleware aliases and groups.
+func (p *RoutingServiceProvider) Boot(app *container.Container) {
+	router := container.Resolve[*routing.Router](app, "router")
+	cors := container.Resolve[*gohttp.CORS](app, "cors")
+	csrf := container.Resolve[*gohttp.CSRF](app, "csrf")
+	apply := func(cfg *config.Config) {
+		router.SetBaseURL(cfg.App.URL)
+		router.SetSigningKey(cfg.App.Key.Reveal())
+		csrf.SetKey(cfg.App.Key.Reveal())
+		cors.Configure(cfg.CORS)
+	}
+	apply(container.Resolve[*config.Config](app, "config"))
+	app.Rebinding("config", func(v any) { apply(v.(*config.Config)) })
+
+	// Laravel: HandleCors — global, so preflight is answered before routing.
+	router.Middleware(cors.Middleware)
+
+	router.AliasMiddleware("csrf", csrf.Middleware)
+	router.AppendMiddlewareToGroup("web", "csrf")
+
+	limiter := container.Resolve[*ratelimit.RateLimiter](app, "limiter")
+	if _, ok := limiter.Limiter("api"); !ok {
+		// Laravel: RateLimiter::for('api', fn ($r) => Limit::perMinute(60)->by($r->ip()))
+		limiter.For("api", func(r *http.Request) ratelimit.Limit {
+			return ratelimit.PerMinute(60).By(ratelimit.IP(r))
+		})
+	}
+	router.AliasMiddlewareFactory("throttle", limiter.Throttle)
+	router.AppendMiddlewareToGroup("api", "throttle:api")
+}
+
 // ── ViewServiceProvider ───────────────────────────────────────────────────────
 
 // ViewServiceProvider registers the template engine.
@@ -65,6 +156,14 @@ func (p *RoutingServiceProvider) Register(app *container.Container) {
 //   - view.dir (default: "./views")
 //   - view.ext (default: ".html")
 //
+// Template helpers registered at boot:
+//   - route      — {{ route "users.show" "id" 1 }} (see routing.Router.URL)
+//   - csrf_field — {{ csrf_field }} hidden _token input; csrf_token for the
+//     raw value. Both need a request: render with views.Request(r).
+//
+// The router renders "errors/404" and "errors/405" for HTML clients when
+// those views exist (see routing.Router.SetViews).
+//
 // Laravel equivalent:
 //
 //	// Illuminate\View\ViewServiceProvider
@@ -89,3 +188,17 @@ func (p *ViewServiceProvider) Register(app *container.Container) {
 		return gohttp.NewViewEngine(dir, ext)
 	})
 }
+
+// Boot exposes the CSRF helpers and the router's template helpers
+// ({{ route ... }}, {{ asset ... }}) to views, and gives the router the
+// engine for its error pages.
+func (p *ViewServiceProvider) Boot(app *container.Container) {
+	views := container.Resolve[*gohttp.ViewEngine](app, "view")
+	views.Funcs(gohttp.CSRFFuncs(nil)).RequestFuncs(gohttp.CSRFFuncs)
+	if !app.Bound("router") {
+		return
+	}
+	router := container.Resolve[*routing.Router](app, "router")
+	views.Funcs(router.TemplateFuncs())
+	router.SetViews(views)
+}
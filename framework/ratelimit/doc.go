@@ -0,0 +1,39 @@
+// Package ratelimit provides Laravel-compatible named rate limiters and the
+// throttle middleware.
+//
+// # Defining Limiters
+//
+// Limiters are named callbacks returning a Limit for the current request,
+// mirroring Laravel's RateLimiter::for():
+//
+//	// Laravel: RateLimiter::for('api', fn ($r) => Limit::perMinute(60)->by($r->user()?->id ?: $r->ip()))
+//	limiter.For("api", func(r *http.Request) ratelimit.Limit {
+//	    if user := auth.User(r); user != nil {
+//	        return ratelimit.PerMinute(120).By("user:" + user.ID)
+//	    }
+//	    return ratelimit.PerMinute(60).By(ratelimit.IP(r))
+//	})
+//
+// # Throttle Middleware
+//
+// RateLimiter.Throttle is registered as the "throttle" middleware alias by
+// RoutingServiceProvider, and "throttle:api" is part of the "api" group:
+//
+//	r.Get("/search", search).Middleware("throttle:api")   // named limiter
+//	r.Post("/login", login).Middleware("throttle:5,1")    // 5 per minute by IP
+//
+// Every throttled response carries X-RateLimit-Limit and
+// X-RateLimit-Remaining; rejected requests get 429 with Retry-After and
+// X-RateLimit-Reset.
+//
+// # Stores
+//
+// Attempts are counted with a sliding window: the current fixed window plus
+// the previous one, weighted by how much of it still overlaps. Counters live
+// in a Store — MemoryStore by default; implement Store over Redis or another
+// shared cache when running several instances:
+//
+//	app.Singleton("limiter", func(c *container.Container) any {
+//	    return ratelimit.New(redisstore.New(client))
+//	})
+package ratelimit
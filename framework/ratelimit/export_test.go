@@ -0,0 +1,6 @@
+package ratelimit
+
+import "time"
+
+// SetClock replaces the limiter's clock so tests can move through windows.
+func SetClock(l *RateLimiter, now func() time.Time) { l.now = now }
@@ -0,0 +1,205 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ── Limit ────────────────────────────────────────────────────────────────────
+
+// Limit is the number of attempts allowed per Decay for one Key.
+// A MaxAttempts of zero or less means unlimited.
+type Limit struct {
+	MaxAttempts int
+	Decay       time.Duration
+	Key         string
+}
+
+// Per allows n attempts per decay — Laravel: new Limit($key, n, decaySeconds).
+func Per(n int, decay time.Duration) Limit { return Limit{MaxAttempts: n, Decay: decay} }
+
+// PerSecond allows n attempts per second — Laravel: Limit::perSecond(n).
+func PerSecond(n int) Limit { return Per(n, time.Second) }
+
+// PerMinute allows n attempts per minute — Laravel: Limit::perMinute(n).
+func PerMinute(n int) Limit { return Per(n, time.Minute) }
+
+// PerMinutes allows n attempts per the given minutes — Laravel: Limit::perMinutes(m, n).
+func PerMinutes(minutes, n int) Limit { return Per(n, time.Duration(minutes)*time.Minute) }
+
+// PerHour allows n attempts per hour — Laravel: Limit::perHour(n).
+func PerHour(n int) Limit { return Per(n, time.Hour) }
+
+// PerDay allows n attempts per day — Laravel: Limit::perDay(n).
+func PerDay(n int) Limit { return Per(n, 24*time.Hour) }
+
+// None is an unlimited Limit — Laravel: Limit::none().
+func None() Limit { return Limit{} }
+
+// By segments the limit, e.g. per user, IP or API token.
+//
+//	ratelimit.PerMinute(60).By(ratelimit.IP(r))
+func (l Limit) By(key string) Limit {
+	l.Key = key
+	return l
+}
+
+// Unlimited reports whether the limit never rejects.
+func (l Limit) Unlimited() bool { return l.MaxAttempts <= 0 || l.Decay <= 0 }
+
+// Result describes a single attempt against a Limit.
+type Result struct {
+	Allowed    bool
+	Limit      int           // MaxAttempts of the applied limit
+	Remaining  int           // attempts left in the current window
+	RetryAfter time.Duration // zero unless rejected
+}
+
+// ── RateLimiter ──────────────────────────────────────────────────────────────
+
+// RateLimiter holds named limiters and counts attempts in a Store.
+//
+//	// Laravel: Illuminate\Cache\RateLimiter
+//	limiter := ratelimit.New(nil) // in-memory
+type RateLimiter struct {
+	store Store
+	now   func() time.Time
+
+	mu       sync.RWMutex
+	limiters map[string]func(*http.Request) Limit
+}
+
+// New creates a RateLimiter; a nil store uses a MemoryStore.
+func New(store Store) *RateLimiter {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &RateLimiter{
+		store:    store,
+		now:      time.Now,
+		limiters: make(map[string]func(*http.Request) Limit),
+	}
+}
+
+// For registers a named limiter, replacing any previous one.
+//
+//	// Laravel: RateLimiter::for('uploads', fn ($r) => Limit::perMinute(10)->by($r->ip()))
+//	limiter.For("uploads", func(r *http.Request) ratelimit.Limit {
+//	    return ratelimit.PerMinute(10).By(ratelimit.IP(r))
+//	})
+func (l *RateLimiter) For(name string, fn func(*http.Request) Limit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limiters[name] = fn
+}
+
+// Limiter returns a named limiter — Laravel: RateLimiter::limiter('api').
+func (l *RateLimiter) Limiter(name string) (func(*http.Request) Limit, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	fn, ok := l.limiters[name]
+	return fn, ok
+}
+
+// Attempt records a hit for limit.Key unless the limit is exhausted.
+// Rejected attempts are not counted. The hit is counted first and given
+// back when over the limit, so concurrent attempts never exceed it.
+//
+//	// Laravel: RateLimiter::attempt('send-message:'.$user->id, 5, fn () => ...)
+//	res, err := limiter.Attempt(ratelimit.PerMinute(5).By("send-message:" + id))
+//	if !res.Allowed { ... retry in res.RetryAfter }
+func (l *RateLimiter) Attempt(limit Limit) (Result, error) {
+	if limit.Unlimited() {
+		return Result{Allowed: true}, nil
+	}
+
+	now := l.now()
+	w := l.window(limit, now)
+	prev, err := l.store.Get(w.prevKey)
+	if err != nil {
+		return Result{}, err
+	}
+	curr, err := l.store.Increment(w.currKey, 2*limit.Decay)
+	if err != nil {
+		return Result{}, err
+	}
+
+	res := Result{Limit: limit.MaxAttempts}
+	if w.count(prev, curr-1) >= float64(limit.MaxAttempts) {
+		if err := l.store.Decrement(w.currKey); err != nil {
+			return Result{}, err
+		}
+		res.RetryAfter = w.retryAfter(prev, curr-1, limit.MaxAttempts)
+		return res, nil
+	}
+	res.Allowed = true
+	res.Remaining = max(limit.MaxAttempts-int(math.Ceil(w.count(prev, curr))), 0)
+	return res, nil
+}
+
+// Clear resets the attempts counted for limit.Key — e.g. after a
+// successful login. Laravel: RateLimiter::clear($key).
+func (l *RateLimiter) Clear(limit Limit) error {
+	if limit.Unlimited() {
+		return nil
+	}
+	w := l.window(limit, l.now())
+	if err := l.store.Delete(w.prevKey); err != nil {
+		return err
+	}
+	return l.store.Delete(w.currKey)
+}
+
+// ── sliding window ───────────────────────────────────────────────────────────
+
+// window is the fixed window containing now plus the one before it.
+type window struct {
+	prevKey, currKey string
+	elapsed, decay   time.Duration
+}
+
+func (l *RateLimiter) window(limit Limit, now time.Time) window {
+	n := now.UnixNano() / int64(limit.Decay)
+	prefix := fmt.Sprintf("ratelimit:%s:%d", limit.Key, limit.Decay.Milliseconds())
+	return window{
+		prevKey: fmt.Sprintf("%s:%d", prefix, n-1),
+		currKey: fmt.Sprintf("%s:%d", prefix, n),
+		elapsed: time.Duration(now.UnixNano() % int64(limit.Decay)),
+		decay:   limit.Decay,
+	}
+}
+
+// count weights the previous window by how much of it still overlaps the
+// sliding window ending now.
+func (w window) count(prev, curr int64) float64 {
+	overlap := 1 - float64(w.elapsed)/float64(w.decay)
+	return float64(prev)*overlap + float64(curr)
+}
+
+// retryAfter returns how long until count drops below maxAttempts.
+func (w window) retryAfter(prev, curr int64, maxAttempts int) time.Duration {
+	limit, d := float64(maxAttempts), float64(w.decay)
+	if float64(curr) < limit && prev > 0 {
+		// prev·(1 − t/d) + curr < limit, within this window.
+		t := time.Duration(d * (1 - (limit-float64(curr))/float64(prev)))
+		return max(t-w.elapsed, time.Millisecond)
+	}
+	// Wait for the next window, where curr becomes the previous one.
+	t := time.Duration(d * (1 - limit/float64(curr)))
+	return w.decay - w.elapsed + t
+}
+
+// ── keys ─────────────────────────────────────────────────────────────────────
+
+// IP returns the client IP without the port, honouring chi's RealIP
+// middleware. Use it to key limits per client.
+func IP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
@@ -0,0 +1,200 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/ratelimit"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+// clock is a settable time source starting at the beginning of a minute.
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time          { return c.t }
+func (c *clock) advance(d time.Duration) { c.t = c.t.Add(d) }
+func newClock() *clock                   { return &clock{t: time.Unix(1_700_000_040, 0)} }
+
+func limiterAt(c *clock) *ratelimit.RateLimiter {
+	l := ratelimit.New(nil)
+	ratelimit.SetClock(l, c.now)
+	return l
+}
+
+func attempt(t *testing.T, l *ratelimit.RateLimiter, limit ratelimit.Limit) ratelimit.Result {
+	t.Helper()
+	res, err := l.Attempt(limit)
+	if err != nil {
+		t.Fatalf("Attempt: %v", err)
+	}
+	return res
+}
+
+// ── Limit ────────────────────────────────────────────────────────────────────
+
+func TestLimit_Constructors(t *testing.T) {
+	cases := map[string]struct {
+		limit ratelimit.Limit
+		decay time.Duration
+	}{
+		"PerSecond":  {ratelimit.PerSecond(5), time.Second},
+		"PerMinute":  {ratelimit.PerMinute(5), time.Minute},
+		"PerMinutes": {ratelimit.PerMinutes(10, 5), 10 * time.Minute},
+		"PerHour":    {ratelimit.PerHour(5), time.Hour},
+		"PerDay":     {ratelimit.PerDay(5), 24 * time.Hour},
+	}
+	for name, tc := range cases {
+		if tc.limit.MaxAttempts != 5 || tc.limit.Decay != tc.decay {
+			t.Errorf("%s: got %+v", name, tc.limit)
+		}
+	}
+	if !ratelimit.None().Unlimited() {
+		t.Error("None should be unlimited")
+	}
+	if got := ratelimit.PerMinute(5).By("user:1").Key; got != "user:1" {
+		t.Errorf("By: got %q", got)
+	}
+}
+
+// ── Attempt ──────────────────────────────────────────────────────────────────
+
+func TestAttempt_AllowsUpToMax(t *testing.T) {
+	l := limiterAt(newClock())
+	limit := ratelimit.PerMinute(3).By("k")
+
+	for i, want := range []int{2, 1, 0} {
+		res := attempt(t, l, limit)
+		if !res.Allowed || res.Remaining != want {
+			t.Fatalf("attempt %d: got %+v, want allowed with %d remaining", i+1, res, want)
+		}
+	}
+	res := attempt(t, l, limit)
+	if res.Allowed {
+		t.Fatal("4th attempt should be rejected")
+	}
+	if res.RetryAfter <= 0 || res.RetryAfter > time.Minute {
+		t.Errorf("RetryAfter = %v", res.RetryAfter)
+	}
+}
+
+func TestAttempt_KeysAreIndependent(t *testing.T) {
+	l := limiterAt(newClock())
+	attempt(t, l, ratelimit.PerMinute(1).By("a"))
+
+	if !attempt(t, l, ratelimit.PerMinute(1).By("b")).Allowed {
+		t.Error("key b should have its own budget")
+	}
+}
+
+func TestAttempt_SlidingWindow(t *testing.T) {
+	c := newClock()
+	l := limiterAt(c)
+	limit := ratelimit.PerMinute(10).By("k")
+
+	for range 10 {
+		attempt(t, l, limit)
+	}
+	// 15s into the next window, 75% of the previous 10 hits still count.
+	c.advance(75 * time.Second)
+	res := attempt(t, l, limit)
+	if !res.Allowed {
+		t.Fatal("expected an attempt to be allowed after the window slid")
+	}
+	if res.Remaining != 1 {
+		t.Errorf("Remaining = %d, want 1 (7.5 + 1 counted)", res.Remaining)
+	}
+
+	c.advance(2 * time.Minute)
+	if res := attempt(t, l, limit); res.Remaining != 9 {
+		t.Errorf("after two idle windows Remaining = %d, want 9", res.Remaining)
+	}
+}
+
+func TestAttempt_RetryAfterReflectsWindow(t *testing.T) {
+	c := newClock()
+	l := limiterAt(c)
+	limit := ratelimit.PerMinute(2).By("k")
+
+	attempt(t, l, limit)
+	attempt(t, l, limit)
+	c.advance(30 * time.Second)
+
+	res := attempt(t, l, limit)
+	if res.Allowed {
+		t.Fatal("expected rejection")
+	}
+	// Next window starts in 30s; the 2 hits then weigh 2·(1−t/60) < 2 right after.
+	if res.RetryAfter < 30*time.Second || res.RetryAfter > 31*time.Second {
+		t.Errorf("RetryAfter = %v, want ~30s", res.RetryAfter)
+	}
+}
+
+func TestAttempt_Unlimited(t *testing.T) {
+	l := limiterAt(newClock())
+	for range 100 {
+		if !attempt(t, l, ratelimit.None()).Allowed {
+			t.Fatal("None should never reject")
+		}
+	}
+}
+
+func TestAttempt_ConcurrentAttemptsNeverExceedMax(t *testing.T) {
+	l := limiterAt(newClock())
+	limit := ratelimit.PerMinute(10).By("k")
+
+	var allowed atomic.Int64
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if attempt(t, l, limit).Allowed {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := allowed.Load(); got != 10 {
+		t.Errorf("allowed %d attempts, want 10", got)
+	}
+}
+
+func TestClear(t *testing.T) {
+	l := limiterAt(newClock())
+	limit := ratelimit.PerMinute(1).By("login:alice")
+	attempt(t, l, limit)
+
+	if err := l.Clear(limit); err != nil {
+		t.Fatal(err)
+	}
+	if !attempt(t, l, limit).Allowed {
+		t.Error("expected attempts to be reset")
+	}
+}
+
+// ── For / Limiter ────────────────────────────────────────────────────────────
+
+func TestFor(t *testing.T) {
+	l := ratelimit.New(nil)
+	l.For("uploads", func(r *http.Request) ratelimit.Limit {
+		return ratelimit.PerMinute(10).By(ratelimit.IP(r))
+	})
+
+	fn, ok := l.Limiter("uploads")
+	if !ok {
+		t.Fatal("limiter not registered")
+	}
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.1:5555"
+	if got := fn(req); got.MaxAttempts != 10 || got.Key != "10.0.0.1" {
+		t.Errorf("got %+v", got)
+	}
+	if _, ok := l.Limiter("missing"); ok {
+		t.Error("expected missing limiter")
+	}
+}
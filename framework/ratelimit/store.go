@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Store keeps attempt counters. Implement it over a shared cache (Redis,
+// Memcached, a database) so several instances share one budget; Increment
+// and Decrement must be atomic.
+type Store interface {
+	// Get returns the counter for key, or 0 if it is missing or expired.
+	Get(key string) (int64, error)
+	// Increment adds one to key, creating it with the given TTL, and returns
+	// the new value.
+	Increment(key string, ttl time.Duration) (int64, error)
+	// Decrement takes one from an existing key, keeping its TTL.
+	Decrement(key string) error
+	// Delete removes key.
+	Delete(key string) error
+}
+
+// MemoryStore is an in-process Store. Counters are lost on restart and are
+// not shared between instances.
+type MemoryStore struct {
+	mu    sync.Mutex
+	items map[string]memoryItem
+	hits  int
+}
+
+type memoryItem struct {
+	value   int64
+	expires time.Time
+}
+
+// sweepEvery is how many increments pass between purges of expired counters.
+const sweepEvery = 1000
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{items: make(map[string]memoryItem)}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, ok := s.items[key]
+	if !ok || time.Now().After(item.expires) {
+		return 0, nil
+	}
+	return item.value, nil
+}
+
+// Increment implements Store.
+func (s *MemoryStore) Increment(key string, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.hits++; s.hits%sweepEvery == 0 {
+		s.sweep(now)
+	}
+
+	item, ok := s.items[key]
+	if !ok || now.After(item.expires) {
+		item = memoryItem{expires: now.Add(ttl)}
+	}
+	item.value++
+	s.items[key] = item
+	return item.value, nil
+}
+
+// Decrement implements Store.
+func (s *MemoryStore) Decrement(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if item, ok := s.items[key]; ok && item.value > 0 {
+		item.value--
+		s.items[key] = item
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.items, key)
+	return nil
+}
+
+// sweep drops expired counters (must hold mu).
+func (s *MemoryStore) sweep(now time.Time) {
+	for k, item := range s.items {
+		if now.After(item.expires) {
+			delete(s.items, k)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/ratelimit"
+)
+
+// ── MemoryStore ──────────────────────────────────────────────────────────────
+
+func TestMemoryStore_IncrementAndGet(t *testing.T) {
+	s := ratelimit.NewMemoryStore()
+	for want := int64(1); want <= 3; want++ {
+		if got, _ := s.Increment("k", time.Minute); got != want {
+			t.Fatalf("Increment = %d want %d", got, want)
+		}
+	}
+	if got, _ := s.Get("k"); got != 3 {
+		t.Errorf("Get = %d want 3", got)
+	}
+	if got, _ := s.Get("missing"); got != 0 {
+		t.Errorf("Get(missing) = %d want 0", got)
+	}
+}
+
+func TestMemoryStore_Expires(t *testing.T) {
+	s := ratelimit.NewMemoryStore()
+	s.Increment("k", 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+
+	if got, _ := s.Get("k"); got != 0 {
+		t.Errorf("Get after TTL = %d want 0", got)
+	}
+	if got, _ := s.Increment("k", time.Minute); got != 1 {
+		t.Errorf("Increment after TTL = %d want 1", got)
+	}
+}
+
+func TestMemoryStore_Delete(t *testing.T) {
+	s := ratelimit.NewMemoryStore()
+	s.Increment("k", time.Minute)
+	_ = s.Delete("k")
+
+	if got, _ := s.Get("k"); got != 0 {
+		t.Errorf("Get after Delete = %d want 0", got)
+	}
+}
+
+func TestMemoryStore_Decrement(t *testing.T) {
+	s := ratelimit.NewMemoryStore()
+	s.Increment("k", time.Minute)
+	s.Increment("k", time.Minute)
+	_ = s.Decrement("k")
+	_ = s.Decrement("missing")
+
+	if got, _ := s.Get("k"); got != 1 {
+		t.Errorf("Get after Decrement = %d want 1", got)
+	}
+	if got, _ := s.Get("missing"); got != 0 {
+		t.Errorf("Decrement(missing) = %d want 0", got)
+	}
+}
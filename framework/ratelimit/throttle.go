@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+)
+
+// ── throttle middleware ──────────────────────────────────────────────────────
+
+// Throttle builds the "throttle" middleware from its alias parameters; it
+// satisfies routing.MiddlewareFactory.
+//
+//	throttle:api     → the limiter registered with For("api", ...)
+//	throttle:60      → 60 attempts per minute, keyed by client IP
+//	throttle:60,5    → 60 attempts per 5 minutes, keyed by client IP
+//
+// It panics on malformed parameters, like an unregistered alias, and a
+// named limiter that is not defined by the time it wraps a route — when the
+// routes compile, before serving.
+//
+//	// Laravel: ->middleware('throttle:60,1')
+//	router.AliasMiddlewareFactory("throttle", limiter.Throttle)
+func (l *RateLimiter) Throttle(params ...string) func(http.Handler) http.Handler {
+	if len(params) == 0 {
+		params = []string{"60"}
+	}
+	name, resolve := l.throttleLimit(params)
+
+	return func(next http.Handler) http.Handler {
+		limitFor := resolve
+		if limitFor == nil {
+			if _, ok := l.Limiter(name); !ok {
+				panic(fmt.Sprintf("ratelimit: rate limiter %q is not defined — see RateLimiter.For", name))
+			}
+			limitFor = func(r *http.Request) Limit {
+				fn, _ := l.Limiter(name)
+				return fn(r)
+			}
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limit := limitFor(r)
+			if limit.Unlimited() {
+				next.ServeHTTP(w, r)
+				return
+			}
+			key := limit.Key
+			if key == "" {
+				key = IP(r)
+			}
+			res, err := l.Attempt(limit.By(name + ":" + key))
+			if err != nil {
+				gohttp.NewResponse(w).ServerError()
+				return
+			}
+
+			h := w.Header()
+			h.Set("X-RateLimit-Limit", strconv.Itoa(res.Limit))
+			h.Set("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			if !res.Allowed {
+				secs := int64(math.Ceil(res.RetryAfter.Seconds()))
+				h.Set("Retry-After", strconv.FormatInt(secs, 10))
+				h.Set("X-RateLimit-Reset", strconv.FormatInt(l.now().Unix()+secs, 10))
+				gohttp.NewResponse(w).TooManyRequests()
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// throttleLimit parses the throttle parameters into a key prefix and a
+// per-request Limit; for a named limiter the Limit is nil. Limiters are
+// never removed, so Throttle looks the name up once per route.
+func (l *RateLimiter) throttleLimit(params []string) (string, func(*http.Request) Limit) {
+	maxAttempts, err := strconv.Atoi(params[0])
+	if err != nil {
+		return params[0], nil
+	}
+
+	minutes := 1
+	if len(params) > 1 {
+		if minutes, err = strconv.Atoi(params[1]); err != nil || minutes < 1 {
+			panic(fmt.Sprintf("ratelimit: invalid throttle decay %q", params[1]))
+		}
+	}
+	limit := Per(maxAttempts, time.Duration(minutes)*time.Minute)
+	name := fmt.Sprintf("throttle:%d,%d", maxAttempts, minutes)
+	return name, func(*http.Request) Limit { return limit }
+}
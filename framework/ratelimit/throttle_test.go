@@ -0,0 +1,104 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/ratelimit"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func throttled(mw func(http.Handler) http.Handler) http.Handler {
+	return mw(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func hit(h http.Handler, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = ip + ":1234"
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	return rr
+}
+
+// ── Throttle ─────────────────────────────────────────────────────────────────
+
+func TestThrottle_InlineLimit(t *testing.T) {
+	l := limiterAt(newClock())
+	h := throttled(l.Throttle("2", "1"))
+
+	for i, want := range []string{"1", "0"} {
+		rr := hit(h, "10.0.0.1")
+		if rr.Code != http.StatusOK {
+			t.Fatalf("hit %d: got %d", i+1, rr.Code)
+		}
+		if rr.Header().Get("X-RateLimit-Limit") != "2" || rr.Header().Get("X-RateLimit-Remaining") != want {
+			t.Errorf("hit %d headers: %v", i+1, rr.Header())
+		}
+	}
+
+	rr := hit(h, "10.0.0.1")
+	if rr.Code != http.StatusTooManyRequests {
+		t.Fatalf("got %d want 429", rr.Code)
+	}
+	retry, err := strconv.Atoi(rr.Header().Get("Retry-After"))
+	if err != nil || retry < 1 || retry > 60 {
+		t.Errorf("Retry-After = %q", rr.Header().Get("Retry-After"))
+	}
+	if rr.Header().Get("X-RateLimit-Reset") == "" {
+		t.Error("missing X-RateLimit-Reset")
+	}
+
+	if rr := hit(h, "10.0.0.2"); rr.Code != http.StatusOK {
+		t.Errorf("other IP: got %d want 200", rr.Code)
+	}
+}
+
+func TestThrottle_NamedLimiter(t *testing.T) {
+	l := limiterAt(newClock())
+	l.For("api", func(r *http.Request) ratelimit.Limit {
+		if r.Header.Get("Authorization") != "" {
+			return ratelimit.None()
+		}
+		return ratelimit.PerMinute(1)
+	})
+	h := throttled(l.Throttle("api"))
+
+	hit(h, "10.0.0.1")
+	if rr := hit(h, "10.0.0.1"); rr.Code != http.StatusTooManyRequests {
+		t.Errorf("anonymous: got %d want 429", rr.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK || rr.Header().Get("X-RateLimit-Limit") != "" {
+		t.Errorf("unlimited: got %d %v", rr.Code, rr.Header())
+	}
+}
+
+func TestThrottle_InvalidParamsPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	ratelimit.New(nil).Throttle("60", "soon")
+}
+
+func TestThrottle_UndefinedLimiterPanicsWhenWrapping(t *testing.T) {
+	l := ratelimit.New(nil)
+	mw := l.Throttle("uploads") // may be defined later, before the routes compile
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic wrapping a handler with an undefined limiter")
+		}
+	}()
+	throttled(mw)
+}
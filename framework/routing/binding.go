@@ -0,0 +1,110 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+)
+
+// ── Route model binding ──────────────────────────────────────────────────────
+
+// ErrModelNotFound is returned by a Binder when no record matches; the
+// request is answered with 404 via Response.NotFound.
+//
+//	// Laravel: ModelNotFoundException
+var ErrModelNotFound = errors.New("routing: model not found")
+
+// Binder resolves a route parameter value into a model.
+// Returning a nil model or ErrModelNotFound responds 404; any other error
+// responds 500.
+type Binder func(r *http.Request, value string) (any, error)
+
+// Model registers a binder for a route parameter. Every route whose pattern
+// contains {param} then resolves it before the handler runs, and the handler
+// reads the result with Bound.
+//
+//	// Laravel: Route::model('user', User::class) / Route::bind('user', fn)
+//	r.Model("user", func(r *http.Request, id string) (any, error) {
+//	    return users.Find(r.Context(), id) // (*User)(nil) → 404
+//	})
+//
+//	r.Get("/users/{user}", func(w http.ResponseWriter, r *http.Request) {
+//	    user := routing.Bound[*User](r, "user")
+//	})
+func (r *Router) Model(param string, resolve Binder) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.binders[param] = resolve
+	r.routes.mux.Store(nil)
+}
+
+// Bound returns the model bound to a route parameter, or the zero value of
+// T if none was bound (or it has a different type).
+//
+//	// Laravel: public function show(User $user)
+//	user := routing.Bound[*User](r, "user")
+func Bound[T any](r *http.Request, param string) T {
+	models, _ := r.Context().Value(boundKey{}).(map[string]any)
+	typed, _ := models[param].(T)
+	return typed
+}
+
+type boundKey struct{}
+
+// bindModels wraps h with the binders matching the route's parameters,
+// run in the order the parameters appear in the URI. It returns h
+// unchanged when the route has no bound parameters.
+func bindModels(h http.Handler, uri string, binders map[string]Binder) http.Handler {
+	type binding struct {
+		name    string
+		resolve Binder
+	}
+	var bound []binding
+	for _, name := range paramNames(uri) {
+		if b, ok := binders[name]; ok {
+			bound = append(bound, binding{name, b})
+		}
+	}
+	if len(bound) == 0 {
+		return h
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		models := make(map[string]any, len(bound))
+		if prev, ok := req.Context().Value(boundKey{}).(map[string]any); ok {
+			for k, v := range prev {
+				models[k] = v
+			}
+		}
+		for _, b := range bound {
+			name := b.name
+			model, err := b.resolve(req, Param(req, name))
+			if errors.Is(err, ErrModelNotFound) || (err == nil && isNil(model)) {
+				gohttp.NewResponse(w).NotFound()
+				return
+			}
+			if err != nil {
+				gohttp.NewResponse(w).ServerError()
+				return
+			}
+			models[name] = model
+		}
+		h.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), boundKey{}, models)))
+	})
+}
+
+// isNil reports whether v is nil or a typed nil pointer, map, slice, etc.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package routing_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+type user struct{ ID, Name string }
+
+var users = map[string]*user{"1": {ID: "1", Name: "Taylor"}}
+
+func findUser(_ *http.Request, id string) (any, error) {
+	if id == "boom" {
+		return nil, errors.New("db down")
+	}
+	return users[id], nil // (*user)(nil) when missing
+}
+
+func bindingRouter() *routing.Router {
+	r := routing.New()
+	r.Model("user", findUser)
+	r.Get("/users/{user}", func(w http.ResponseWriter, req *http.Request) {
+		u := routing.Bound[*user](req, "user")
+		_, _ = w.Write([]byte(u.Name))
+	})
+	return r
+}
+
+// ── Model binding ─────────────────────────────────────────────────────────────
+
+func TestModel_BindsParameter(t *testing.T) {
+	rr := do(t, bindingRouter(), http.MethodGet, "/users/1")
+	if rr.Code != http.StatusOK || rr.Body.String() != "Taylor" {
+		t.Errorf("got %d %q want 200 %q", rr.Code, rr.Body.String(), "Taylor")
+	}
+}
+
+func TestModel_MissingRecordIs404(t *testing.T) {
+	rr := do(t, bindingRouter(), http.MethodGet, "/users/99")
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("got %d want 404", rr.Code)
+	}
+}
+
+func TestModel_ErrModelNotFoundIs404(t *testing.T) {
+	r := routing.New()
+	r.Model("post", func(*http.Request, string) (any, error) { return nil, routing.ErrModelNotFound })
+	r.Get("/posts/{post}", okHandler)
+
+	if rr := do(t, r, http.MethodGet, "/posts/1"); rr.Code != http.StatusNotFound {
+		t.Errorf("got %d want 404", rr.Code)
+	}
+}
+
+func TestModel_ResolverErrorIs500(t *testing.T) {
+	rr := do(t, bindingRouter(), http.MethodGet, "/users/boom")
+	if rr.Code != http.StatusInternalServerError {
+		t.Errorf("got %d want 500", rr.Code)
+	}
+}
+
+func TestModel_BindsInURIOrder(t *testing.T) {
+	r := routing.New()
+	var order []string
+	for _, name := range []string{"c", "a", "d", "b"} {
+		r.Model(name, func(_ *http.Request, v string) (any, error) {
+			order = append(order, name)
+			return v, nil
+		})
+	}
+	r.Get("/{b}/{d}/{a}/{c}", okHandler)
+
+	for range 20 {
+		order = nil
+		do(t, r, http.MethodGet, "/1/2/3/4")
+		if got := strings.Join(order, ""); got != "bdac" {
+			t.Fatalf("binders ran in order %q, want %q", got, "bdac")
+		}
+	}
+}
+
+func TestBound_ZeroWhenUnbound(t *testing.T) {
+	r := routing.New()
+	var got *user
+	r.Get("/plain/{id}", func(w http.ResponseWriter, req *http.Request) {
+		got = routing.Bound[*user](req, "id")
+	})
+	do(t, r, http.MethodGet, "/plain/1")
+	if got != nil {
+		t.Errorf("expected nil, got %+v", got)
+	}
+}
@@ -0,0 +1,454 @@
+package routing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/http/validation"
+)
+
+// ── Handler registry ─────────────────────────────────────────────────────────
+
+// registeredHandler is a handler added with Router.RegisterHandler.
+type registeredHandler struct {
+	handler http.Handler
+	value   any          // as given to RegisterHandler, see sameHandler
+	action  string       // name the handler would get in Router.List
+	result  reflect.Type // see resultType
+}
+
+// RegisterHandler registers a handler under key. Routes may then reference
+// it by key instead of by value, which is what lets them be cached:
+//
+//	// Laravel: Route::get('/users', 'UserController@index')
+//	r.RegisterHandler("users.index", routing.Action(&UserController{}, "Index"))
+//	r.Get("/users", "users.index")
+//
+// Routes given a handler value rather than a key are cacheable too when
+// that same value — the same pointer, or the same controller and method —
+// is registered under exactly one key. Functions can't be compared, so
+// routes must reference them by key. Keys are resolved when the routes
+// compile, so handlers may be registered before or after the routes using
+// them.
+func (r *Router) RegisterHandler(key string, h any) {
+	handler, action := r.routes.handlerFor(h)
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.handlers[key] = registeredHandler{handler: handler, value: h, action: action, result: resultType(h)}
+	r.routes.mux.Store(nil)
+}
+
+// setHandler sets the route's handler from a value accepted by Match, or
+// records the key of a registered handler.
+func (c *routeCollection) setHandler(rt *Route, h any) {
+	if key, ok := h.(string); ok {
+		rt.handlerKey, rt.action = key, key
+		return
+	}
+	rt.handler, rt.action = c.handlerFor(h)
+	rt.value, rt.result = h, resultType(h)
+}
+
+// routeHandler returns the route's handler, looking up registered handler
+// keys (must hold mu). Like unknown middleware aliases, an unknown key
+// panics when the routes compile.
+func (c *routeCollection) routeHandler(rt *Route) http.Handler {
+	switch {
+	case rt.redirect != nil:
+		return redirectHandler(rt.redirect)
+	case rt.view != nil:
+		return c.viewHandler(rt)
+	case rt.handlerKey == "":
+		return rt.handler
+	}
+	reg, ok := c.handlers[rt.handlerKey]
+	if !ok {
+		panic(fmt.Sprintf("routing: handler %q is not registered — see Router.RegisterHandler", rt.handlerKey))
+	}
+	return reg.handler
+}
+
+// checkHandler reports typed handler parameters that are not bound in the
+// container (must hold mu).
+func (c *routeCollection) checkHandler(rt *Route) error {
+	h := rt.handler
+	if rt.handlerKey != "" {
+		h = c.handlers[rt.handlerKey].handler
+	}
+	typed, ok := h.(*typedHandler)
+	if !ok {
+		return nil
+	}
+	if keys := typed.unbound(); len(keys) > 0 {
+		return fmt.Errorf("route %s: handler %s needs %s, not bound in the container", describe(rt), typed.name, strings.Join(keys, ", "))
+	}
+	return nil
+}
+
+// cacheKey returns the registered key the route's handler can be cached
+// under (must hold mu).
+func (c *routeCollection) cacheKey(rt *Route) (string, error) {
+	if rt.handlerKey != "" {
+		return rt.handlerKey, nil
+	}
+	var keys []string
+	for key, reg := range c.handlers {
+		if sameHandler(reg.value, rt.value) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	switch len(keys) {
+	case 0:
+		if v := reflect.ValueOf(rt.value); v.Kind() == reflect.Func {
+			return "", fmt.Errorf("route %s: handler %s is not registered — a function must be referenced by its key, see Router.RegisterHandler", describe(rt), rt.action)
+		}
+		return "", fmt.Errorf("route %s: handler %s is not registered — see Router.RegisterHandler", describe(rt), rt.action)
+	case 1:
+		return keys[0], nil
+	}
+	return "", fmt.Errorf("route %s: handler %s matches registered handlers %s — reference it by key", describe(rt), rt.action, strings.Join(keys, ", "))
+}
+
+// sameHandler reports whether two handler values are the same handler:
+// the same pointer or an equal comparable value. Controller actions compare
+// their controller and method. Functions never match: closures and method
+// values share code with their siblings, so equal code pointers don't make
+// them the same handler.
+func sameHandler(a, b any) bool {
+	if ca, ok := a.(ControllerAction); ok {
+		cb, ok := b.(ControllerAction)
+		return ok && ca.Method == cb.Method && sameHandler(ca.Controller, cb.Controller)
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() || va.Type() != vb.Type() {
+		return false
+	}
+	switch va.Kind() {
+	case reflect.Func:
+		return false
+	case reflect.Pointer, reflect.Map, reflect.Chan, reflect.UnsafePointer:
+		return va.Pointer() == vb.Pointer()
+	}
+	return va.Comparable() && va.Equal(vb)
+}
+
+// ── Validation ───────────────────────────────────────────────────────────────
+
+// Validate reports routes sharing a name, routes answering the same
+// method and path — parameter names don't matter, so /users/{id} and
+// /users/{user} clash unless their constraints differ — middleware names
+// with no alias or in a group that includes itself, and handler parameters
+// the container cannot make.
+func (r *Router) Validate() error {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	return r.routes.validate()
+}
+
+// validate implements Validate (must hold mu).
+func (c *routeCollection) validate() error {
+	var errs []error
+	names := map[string]*Route{}
+	paths := map[string]*Route{}
+	if err := c.middleware.cycles(); err != nil {
+		errs = append(errs, err)
+	}
+	for _, rt := range c.routes {
+		if err := c.checkMiddleware(rt); err != nil {
+			errs = append(errs, err)
+		}
+		if err := c.checkHandler(rt); err != nil {
+			errs = append(errs, err)
+		}
+		if rt.name != "" {
+			if prev, ok := names[rt.name]; ok {
+				errs = append(errs, fmt.Errorf("route name %q is used by %s and %s", rt.name, describe(prev), describe(rt)))
+			} else {
+				names[rt.name] = rt
+			}
+		}
+		shape := routeShape(c.constrainedDomain(rt)) + routeShape(c.constrained(rt))
+		for _, m := range rt.methods {
+			key := m + " " + shape
+			if prev, ok := paths[key]; ok && prev != rt {
+				errs = append(errs, fmt.Errorf("%s %s is registered by %s and %s", m, rt.domain+rt.uri, describe(prev), describe(rt)))
+			} else {
+				paths[key] = rt
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// routeShape drops parameter names from a constrained pattern, keeping what
+// chi matches on: "/users/{id:(?:[0-9]+)}" becomes "/users/{(?:[0-9]+)}".
+func routeShape(pattern string) string {
+	var b strings.Builder
+	for _, part := range splitPattern(pattern) {
+		switch part.param {
+		case "":
+			b.WriteString(part.literal)
+		case "*":
+			b.WriteString("*")
+		default:
+			b.WriteString("{" + part.regex + "}")
+		}
+	}
+	return b.String()
+}
+
+// describe names a route in error messages, e.g. "[GET /users users.index]".
+func describe(rt *Route) string {
+	s := strings.Join(rt.methods, "|") + " " + rt.domain + rt.uri
+	if rt.name != "" {
+		s += " " + rt.name
+	}
+	return "[" + s + "]"
+}
+
+// ── Route cache ──────────────────────────────────────────────────────────────
+
+// routeCacheVersion is bumped when the cache format changes.
+const routeCacheVersion = 1
+
+type routeCache struct {
+	Version     int                `json:"version"`
+	Patterns    map[string]string  `json:"patterns,omitempty"`
+	APIVersions []cachedVersionSet `json:"api_versions,omitempty"`
+	Routes      []cachedRoute      `json:"routes"`
+}
+
+// cachedVersionSet is the versions under one prefix (see Router.Version).
+type cachedVersionSet struct {
+	Domain   string          `json:"domain,omitempty"`
+	Prefix   string          `json:"prefix"`
+	Default  string          `json:"default,omitempty"`
+	Versions []cachedVersion `json:"versions"`
+}
+
+type cachedVersion struct {
+	Name        string `json:"name"`
+	Deprecation string `json:"deprecation,omitempty"`
+	Sunset      string `json:"sunset,omitempty"`
+}
+
+// cachedRoute is a route with its group attributes applied: full URI and
+// name, effective middleware, and the key of its registered handler.
+type cachedRoute struct {
+	Methods    []string          `json:"methods"`
+	Domain     string            `json:"domain,omitempty"`
+	URI        string            `json:"uri"`
+	Name       string            `json:"name,omitempty"`
+	Handler    string            `json:"handler"`
+	Middleware []string          `json:"middleware,omitempty"`
+	Wheres     map[string]string `json:"wheres,omitempty"`
+	Fallback   bool              `json:"fallback,omitempty"`
+	APIVersion string            `json:"api_version,omitempty"` // see versionKey
+	Timeout    time.Duration     `json:"timeout,omitempty"`     // nanoseconds
+	MaxBody    int64             `json:"max_body,omitempty"`
+	Redirect   *cachedRedirect   `json:"redirect,omitempty"`
+	View       *cachedView       `json:"view,omitempty"`
+	Doc        cachedDoc         `json:"doc"`
+}
+
+// cachedRedirect is a Router.Redirect target.
+type cachedRedirect struct {
+	To     string `json:"to"`
+	Status int    `json:"status"`
+}
+
+// cachedView is a Router.View template; data goes through JSON, so numbers
+// come back as float64.
+type cachedView struct {
+	Name string         `json:"name"`
+	Data map[string]any `json:"data,omitempty"`
+}
+
+// cachedDoc is the part of RouteDoc that survives caching; types recorded
+// with Accepts and Returns do not.
+type cachedDoc struct {
+	Summary     string           `json:"summary,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Tags        []string         `json:"tags,omitempty"`
+	Rules       validation.Rules `json:"rules,omitempty"`
+	Hidden      bool             `json:"hidden,omitempty"`
+}
+
+// Cache validates the routes (see Validate) and writes them to w as JSON
+// for LoadCache — Laravel's `php artisan route:cache`. Every handler must be
+// registered with RegisterHandler and every group middleware must be named;
+// the errors list each route that is not. Global middleware, middleware
+// aliases and model binders are not cached: keep registering them at boot.
+func (r *Router) Cache(w io.Writer) error {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	c := r.routes
+	if err := c.validate(); err != nil {
+		return err
+	}
+
+	cache := routeCache{Version: routeCacheVersion, Patterns: c.patterns, Routes: []cachedRoute{}}
+	for _, set := range c.versionSets {
+		cs := cachedVersionSet{Domain: set.domain, Prefix: set.prefix}
+		if set.def != nil {
+			cs.Default = set.def.name
+		}
+		for _, v := range set.versions {
+			cs.Versions = append(cs.Versions, cachedVersion{Name: v.name, Deprecation: v.deprecation, Sunset: v.sunset})
+		}
+		cache.APIVersions = append(cache.APIVersions, cs)
+	}
+	var errs []error
+	for _, rt := range c.routes {
+		key, err := c.cacheKey(rt)
+		if err != nil && rt.redirect == nil && rt.view == nil {
+			errs = append(errs, err)
+			continue
+		}
+		timeout, maxBody := c.limits(rt)
+		cached := cachedRoute{
+			Methods:    rt.methods,
+			Domain:     rt.domain,
+			URI:        rt.uri,
+			Name:       rt.name,
+			Handler:    key,
+			Wheres:     rt.wheres,
+			Fallback:   rt.fallback,
+			APIVersion: versionKey(rt.version),
+			Timeout:    timeout,
+			MaxBody:    maxBody,
+			Doc: cachedDoc{
+				Summary:     rt.doc.Summary,
+				Description: rt.doc.Description,
+				Tags:        rt.doc.Tags,
+				Rules:       rt.doc.Rules,
+				Hidden:      rt.doc.Hidden,
+			},
+		}
+		if rt.redirect != nil {
+			cached.Redirect = &cachedRedirect{To: rt.redirect.to, Status: rt.redirect.status}
+		}
+		if rt.view != nil {
+			cached.View = &cachedView{Name: rt.view.name, Data: rt.view.data}
+		}
+		for _, e := range c.middlewareEntries(rt) {
+			if e.name == "" {
+				errs = append(errs, fmt.Errorf("route %s: middleware %s has no name — see Router.AliasMiddleware", describe(rt), funcName(e.fn)))
+				continue
+			}
+			cached.Middleware = append(cached.Middleware, e.name)
+		}
+		cache.Routes = append(cache.Routes, cached)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(cache)
+}
+
+// LoadCache adds the routes written by Cache, in place of registering them
+// again. Handler keys are resolved when the routes compile, so the handlers
+// must still be registered (see RegisterHandler).
+//
+//	if f, err := os.Open("bootstrap/cache/routes.json"); err == nil {
+//	    defer f.Close()
+//	    err = r.LoadCache(f)
+//	}
+func (r *Router) LoadCache(rd io.Reader) error {
+	var cache routeCache
+	if err := json.NewDecoder(rd).Decode(&cache); err != nil {
+		return fmt.Errorf("routing: reading route cache: %w", err)
+	}
+	if cache.Version != routeCacheVersion {
+		return fmt.Errorf("routing: route cache version %d, want %d — run route:cache again", cache.Version, routeCacheVersion)
+	}
+
+	c := r.routes
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for param, regex := range cache.Patterns {
+		c.patterns[param] = regex
+	}
+	versions := map[string]*APIVersion{}
+	for _, cs := range cache.APIVersions {
+		for _, cv := range cs.Versions {
+			v := c.apiVersionLocked(cs.Domain, cs.Prefix, cv.Name)
+			v.deprecation, v.sunset = cv.Deprecation, cv.Sunset
+			if cv.Name == cs.Default {
+				v.set.def = v
+			}
+			versions[versionKey(v)] = v
+		}
+	}
+	for _, cr := range cache.Routes {
+		rt := &Route{
+			methods:    cr.Methods,
+			uri:        cr.URI,
+			domain:     cr.Domain,
+			name:       cr.Name,
+			handlerKey: cr.Handler,
+			action:     cr.Handler,
+			fallback:   cr.Fallback,
+			wheres:     cr.Wheres,
+			middleware: cr.Middleware,
+			version:    versions[cr.APIVersion],
+			timeout:    cr.Timeout,
+			maxBody:    cr.MaxBody,
+			group:      c.root,
+			routes:     c,
+		}
+		rt.doc = RouteDoc{
+			Summary:     cr.Doc.Summary,
+			Description: cr.Doc.Description,
+			Tags:        cr.Doc.Tags,
+			Rules:       cr.Doc.Rules,
+			Responses:   map[int]reflect.Type{},
+			Hidden:      cr.Doc.Hidden,
+		}
+		if cr.Redirect != nil {
+			rt.redirect = &redirectRoute{to: cr.Redirect.To, status: cr.Redirect.Status}
+			rt.action = "redirect:" + cr.Redirect.To
+		}
+		if cr.View != nil {
+			rt.view = &viewRoute{name: cr.View.Name, data: cr.View.Data}
+			rt.action = "view:" + cr.View.Name
+		}
+		if rt.name != "" {
+			c.byName[rt.name] = rt
+		}
+		c.routes = append(c.routes, rt)
+	}
+	c.cached = true
+	c.mux.Store(nil)
+	return nil
+}
+
+// versionKey identifies a version in the cache, e.g. "example.com /api v2".
+func versionKey(v *APIVersion) string {
+	if v == nil {
+		return ""
+	}
+	return v.set.domain + " " + v.set.prefix + " " + v.name
+}
+
+// Cached reports whether routes were loaded with LoadCache. Code that
+// registers routes at boot — packages especially — should skip that when
+// it returns true, as the cache already holds them.
+//
+//	// Laravel: if (! $this->app->routesAreCached()) { ... }
+func (r *Router) Cached() bool {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	return r.routes.cached
+}
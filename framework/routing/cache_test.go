@@ -0,0 +1,252 @@
+package routing_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+// registerApp registers the handlers and middleware an app sets up on every
+// boot, cached or not.
+func registerApp(r *routing.Router) {
+	r.AliasMiddleware("tag", func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("X-Tag", "1")
+			next.ServeHTTP(w, req)
+		})
+	})
+	r.RegisterHandler("users.show", echoParam("id"))
+	r.RegisterHandler("tenant.home", echoParam("tenant"))
+	r.RegisterHandler("missing", write("fallback"))
+	r.RegisterHandler("ok", okHandler)
+}
+
+// registerRoutes is the app's route file.
+func registerRoutes(r *routing.Router) {
+	r.With("tag").As("api.").Prefix("/api", func(api *routing.Router) {
+		api.Get("/users/{id}", "users.show").Name("users.show").WhereNumber("id").Summary("Show a user")
+	})
+	r.Domain("{tenant}.example.com", func(tenant *routing.Router) {
+		tenant.Get("/", "tenant.home").Name("tenant.home")
+	})
+	r.Get("/ok", "ok").Name("ok")
+	r.Fallback("missing")
+}
+
+func cachedRouter(t *testing.T) *routing.Router {
+	t.Helper()
+	src := routing.New()
+	registerApp(src)
+	registerRoutes(src)
+	var buf bytes.Buffer
+	if err := src.Cache(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	r := routing.New()
+	registerApp(r)
+	if err := r.LoadCache(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// ── Handler keys ─────────────────────────────────────────────────────────────
+
+func TestRegisterHandler_Key(t *testing.T) {
+	r := routing.New()
+	r.Get("/users/{id}", "users.show")
+	r.RegisterHandler("users.show", echoParam("id")) // after the route is fine
+	if got := do(t, r, http.MethodGet, "/users/7").Body.String(); got != "7" {
+		t.Errorf("got %q want 7", got)
+	}
+}
+
+func TestRegisterHandler_UnknownKeyPanics(t *testing.T) {
+	r := routing.New()
+	r.Get("/", "nope")
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for an unregistered handler key")
+		}
+	}()
+	do(t, r, http.MethodGet, "/")
+}
+
+// ── Validate ─────────────────────────────────────────────────────────────────
+
+func TestValidate_DuplicateName(t *testing.T) {
+	r := routing.New()
+	r.Get("/a", okHandler).Name("dup")
+	r.Get("/b", okHandler).Name("dup")
+	err := r.Validate()
+	if err == nil || !strings.Contains(err.Error(), `route name "dup"`) {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestValidate_DuplicateMethodPath(t *testing.T) {
+	r := routing.New()
+	r.Get("/users/{id}", okHandler)
+	r.Match([]string{"GET", "POST"}, "/users/{user}", okHandler)
+	err := r.Validate()
+	if err == nil || !strings.Contains(err.Error(), "GET /users/{user}") {
+		t.Errorf("got %v", err)
+	}
+	if strings.Count(err.Error(), "\n") != 0 {
+		t.Errorf("only GET is duplicated: %v", err)
+	}
+}
+
+func TestValidate_DistinctConstraintsAndDomains(t *testing.T) {
+	r := routing.New()
+	r.Get("/users/{id}", okHandler).WhereNumber("id")
+	r.Get("/users/{slug}", okHandler).WhereAlpha("slug")
+	r.Domain("admin.example.com", func(admin *routing.Router) {
+		admin.Get("/users/{id}", okHandler).WhereNumber("id")
+	})
+	if err := r.Validate(); err != nil {
+		t.Errorf("got %v", err)
+	}
+}
+
+// ── Cache / LoadCache ────────────────────────────────────────────────────────
+
+func TestCache_RoundTrip(t *testing.T) {
+	r := cachedRouter(t)
+	if !r.Cached() {
+		t.Error("Cached() = false after LoadCache")
+	}
+
+	rr := do(t, r, http.MethodGet, "/api/users/7")
+	if rr.Body.String() != "7" || rr.Header().Get("X-Tag") != "1" {
+		t.Errorf("route: got %q X-Tag %q", rr.Body.String(), rr.Header().Get("X-Tag"))
+	}
+	if rr := do(t, r, http.MethodGet, "/api/users/abc"); rr.Body.String() != "fallback" {
+		t.Errorf("constraint not cached: got %q", rr.Body.String())
+	}
+	if got := doHost(t, r, http.MethodGet, "acme.example.com", "/").Body.String(); got != "acme" {
+		t.Errorf("domain route: got %q", got)
+	}
+	if got, err := r.Path("api.users.show", map[string]any{"id": 7}); err != nil || got != "/api/users/7" {
+		t.Errorf("name: got %q, %v", got, err)
+	}
+
+	infos := r.List(routing.RouteFilter{Name: "api.users.show"})
+	if len(infos) != 1 || infos[0].Doc.Summary != "Show a user" || strings.Join(infos[0].Middleware, ",") != "tag" {
+		t.Errorf("info: %+v", infos)
+	}
+}
+
+func TestCache_RejectsFunctionValues(t *testing.T) {
+	// Even registered, a function passed by value can't be matched to its
+	// key: closures and method values share code with their siblings.
+	r := routing.New()
+	r.RegisterHandler("ok", okHandler)
+	r.Get("/ok", okHandler)
+
+	err := r.Cache(&bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "must be referenced by its key") {
+		t.Errorf("got %v", err)
+	}
+}
+
+// upstream is a handler type apps create several instances of.
+type upstream struct{ name string }
+
+func (u *upstream) ServeHTTP(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write([]byte(u.name)) }
+
+func TestCache_SameTypeHandlersKeepTheirKeys(t *testing.T) {
+	users, orders := &upstream{"users"}, &upstream{"orders"}
+	register := func(r *routing.Router) {
+		r.RegisterHandler("orders", orders)
+		r.RegisterHandler("users", users)
+		r.RegisterHandler("users.show", routing.Action(users, "ServeHTTP"))
+	}
+	src := routing.New()
+	register(src)
+	src.Get("/users", users)
+	src.Get("/orders", orders)
+	src.Get("/users/{id}", routing.Action(users, "ServeHTTP"))
+	src.Get("/orders/{id}", routing.Action(orders, "ServeHTTP"))
+
+	if err := src.Cache(&bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "GET /orders/{id}") {
+		t.Fatalf("unregistered action of another instance: got %v", err)
+	}
+	src = routing.New()
+	register(src)
+	src.Get("/users", users)
+	src.Get("/orders", orders)
+	src.Get("/users/{id}", routing.Action(users, "ServeHTTP"))
+	var buf bytes.Buffer
+	if err := src.Cache(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	r := routing.New()
+	register(r)
+	if err := r.LoadCache(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]string{"/users": "users", "/orders": "orders", "/users/1": "users"} {
+		if got := do(t, r, http.MethodGet, path).Body.String(); got != want {
+			t.Errorf("%s: got %q want %q", path, got, want)
+		}
+	}
+}
+
+func TestCache_RejectsAmbiguousValues(t *testing.T) {
+	shared := &upstream{"shared"}
+	r := routing.New()
+	r.RegisterHandler("a", shared)
+	r.RegisterHandler("b", shared)
+	r.Get("/a", shared)
+
+	err := r.Cache(&bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "a, b") {
+		t.Errorf("got %v, want both keys reported", err)
+	}
+}
+
+func TestCache_RejectsUnregistered(t *testing.T) {
+	r := routing.New()
+	r.Get("/closure", func(w http.ResponseWriter, _ *http.Request) {})
+	r.Group(func(g *routing.Router) {
+		g.Middleware(passthrough)
+		g.Get("/anon", "ok")
+	})
+	r.RegisterHandler("ok", okHandler)
+
+	err := r.Cache(&bytes.Buffer{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, want := range []string{"GET /closure", "is not registered", "GET /anon", "has no name"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestCache_ValidatesFirst(t *testing.T) {
+	r := routing.New()
+	r.RegisterHandler("ok", okHandler)
+	r.Get("/a", "ok").Name("dup")
+	r.Get("/b", "ok").Name("dup")
+	if err := r.Cache(&bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "dup") {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestLoadCache_VersionMismatch(t *testing.T) {
+	r := routing.New()
+	err := r.LoadCache(strings.NewReader(`{"version": 99, "routes": []}`))
+	if err == nil || !strings.Contains(err.Error(), "version 99") {
+		t.Errorf("got %v", err)
+	}
+}
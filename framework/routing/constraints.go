@@ -0,0 +1,123 @@
+package routing
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ── Parameter constraints ────────────────────────────────────────────────────
+
+// Common constraint expressions, as used by the Where* helpers.
+const (
+	PatternNumber       = `[0-9]+`
+	PatternAlpha        = `[a-zA-Z]+`
+	PatternAlphaNumeric = `[a-zA-Z0-9]+`
+	PatternUUID         = `[\da-fA-F]{8}-[\da-fA-F]{4}-[\da-fA-F]{4}-[\da-fA-F]{4}-[\da-fA-F]{12}`
+)
+
+// Where constrains a route parameter with a regular expression. Requests
+// whose parameter does not match get 404 instead of reaching the handler,
+// and URL generation rejects values that do not match.
+//
+//	// Laravel: ->where('id', '[0-9]+')
+//	r.Get("/users/{id}", showUser).Where("id", "[0-9]+")
+func (rt *Route) Where(param, regex string) *Route {
+	mustCompileConstraint(param, regex)
+	rt.routes.mu.Lock()
+	defer rt.routes.mu.Unlock()
+	if rt.wheres == nil {
+		rt.wheres = make(map[string]string)
+	}
+	rt.wheres[param] = regex
+	rt.routes.mux.Store(nil)
+	return rt
+}
+
+// WhereNumber constrains parameters to digits — Laravel: ->whereNumber('id').
+func (rt *Route) WhereNumber(params ...string) *Route { return rt.whereAll(params, PatternNumber) }
+
+// WhereAlpha constrains parameters to letters — Laravel: ->whereAlpha('name').
+func (rt *Route) WhereAlpha(params ...string) *Route { return rt.whereAll(params, PatternAlpha) }
+
+// WhereAlphaNumeric constrains parameters to letters and digits —
+// Laravel: ->whereAlphaNumeric('slug').
+func (rt *Route) WhereAlphaNumeric(params ...string) *Route {
+	return rt.whereAll(params, PatternAlphaNumeric)
+}
+
+// WhereUUID constrains parameters to UUIDs — Laravel: ->whereUuid('id').
+func (rt *Route) WhereUUID(params ...string) *Route { return rt.whereAll(params, PatternUUID) }
+
+// WhereIn constrains a parameter to one of the given values.
+//
+//	// Laravel: ->whereIn('category', ['movie', 'song'])
+//	r.Get("/category/{category}", show).WhereIn("category", "movie", "song")
+func (rt *Route) WhereIn(param string, values ...string) *Route {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = regexp.QuoteMeta(v)
+	}
+	return rt.Where(param, strings.Join(quoted, "|"))
+}
+
+func (rt *Route) whereAll(params []string, regex string) *Route {
+	for _, p := range params {
+		rt.Where(p, regex)
+	}
+	return rt
+}
+
+// Pattern sets a global constraint applied to every route with the
+// parameter, unless the route sets its own.
+//
+//	// Laravel: Route::pattern('id', '[0-9]+')
+//	r.Pattern("id", routing.PatternNumber)
+func (r *Router) Pattern(param, regex string) {
+	mustCompileConstraint(param, regex)
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.patterns[param] = regex
+	r.routes.mux.Store(nil)
+}
+
+// constrained returns the route's chi pattern with constraints applied, in
+// order of precedence: Where, an inline {param:regex}, then Pattern
+// (must hold mu).
+func (c *routeCollection) constrained(rt *Route) string { return c.constrain(rt, rt.uri) }
+
+// constrainedDomain is constrained for the route's domain pattern.
+func (c *routeCollection) constrainedDomain(rt *Route) string { return c.constrain(rt, rt.domain) }
+
+func (c *routeCollection) constrain(rt *Route, pattern string) string {
+	var b strings.Builder
+	for _, part := range splitPattern(pattern) {
+		switch {
+		case part.param == "":
+			b.WriteString(part.literal)
+		case part.param == "*":
+			b.WriteString("*")
+		default:
+			regex := part.regex
+			if where, ok := rt.wheres[part.param]; ok {
+				regex = where
+			} else if global, ok := c.patterns[part.param]; ok && regex == "" {
+				regex = global
+			}
+			b.WriteString("{" + part.param)
+			if regex != "" {
+				// chi anchors the expression as ^regex$; group it so
+				// alternations like "a|b" stay anchored.
+				b.WriteString(":(?:" + regex + ")")
+			}
+			b.WriteString("}")
+		}
+	}
+	return b.String()
+}
+
+func mustCompileConstraint(param, regex string) {
+	if _, err := regexp.Compile(regex); err != nil {
+		panic(fmt.Sprintf("routing: invalid constraint for {%s}: %v", param, err))
+	}
+}
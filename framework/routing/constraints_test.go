@@ -0,0 +1,141 @@
+package routing_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── Where ─────────────────────────────────────────────────────────────────────
+
+func TestWhere_NonMatchingParamIs404(t *testing.T) {
+	r := routing.New()
+	r.Get("/users/{id}", okHandler).Where("id", "[0-9]+")
+
+	if rr := do(t, r, http.MethodGet, "/users/abc"); rr.Code != http.StatusNotFound {
+		t.Errorf("/users/abc: got %d want 404", rr.Code)
+	}
+	if rr := do(t, r, http.MethodGet, "/users/42"); rr.Code != http.StatusOK {
+		t.Errorf("/users/42: got %d want 200", rr.Code)
+	}
+}
+
+func TestWhere_AlternationIsAnchored(t *testing.T) {
+	r := routing.New()
+	r.Get("/category/{category}", okHandler).WhereIn("category", "movie", "song")
+
+	for path, want := range map[string]int{
+		"/category/movie":     http.StatusOK,
+		"/category/song":      http.StatusOK,
+		"/category/movies":    http.StatusNotFound,
+		"/category/xsong":     http.StatusNotFound,
+		"/category/book":      http.StatusNotFound,
+		"/category/movie|son": http.StatusNotFound,
+	} {
+		if rr := do(t, r, http.MethodGet, path); rr.Code != want {
+			t.Errorf("%s: got %d want %d", path, rr.Code, want)
+		}
+	}
+}
+
+func TestWhere_Helpers(t *testing.T) {
+	r := routing.New()
+	r.Get("/n/{id}", okHandler).WhereNumber("id")
+	r.Get("/a/{name}", okHandler).WhereAlpha("name")
+	r.Get("/an/{slug}", okHandler).WhereAlphaNumeric("slug")
+	r.Get("/u/{id}", okHandler).WhereUUID("id")
+
+	for path, want := range map[string]int{
+		"/n/123":  http.StatusOK,
+		"/n/12a":  http.StatusNotFound,
+		"/a/abc":  http.StatusOK,
+		"/a/ab1":  http.StatusNotFound,
+		"/an/ab1": http.StatusOK,
+		"/an/a-1": http.StatusNotFound,
+		"/u/123e4567-e89b-12d3-a456-426614174000": http.StatusOK,
+		"/u/123e4567": http.StatusNotFound,
+	} {
+		if rr := do(t, r, http.MethodGet, path); rr.Code != want {
+			t.Errorf("%s: got %d want %d", path, rr.Code, want)
+		}
+	}
+}
+
+func TestWhere_AfterFirstRequestRecompiles(t *testing.T) {
+	r := routing.New()
+	rt := r.Get("/users/{id}", okHandler)
+	if rr := do(t, r, http.MethodGet, "/users/abc"); rr.Code != http.StatusOK {
+		t.Fatalf("unconstrained: got %d want 200", rr.Code)
+	}
+	rt.WhereNumber("id")
+	if rr := do(t, r, http.MethodGet, "/users/abc"); rr.Code != http.StatusNotFound {
+		t.Errorf("constrained: got %d want 404", rr.Code)
+	}
+}
+
+func TestWhere_InvalidRegexPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for invalid regex")
+		}
+	}()
+	routing.New().Get("/users/{id}", okHandler).Where("id", "[0-9")
+}
+
+// ── Pattern ───────────────────────────────────────────────────────────────────
+
+func TestPattern_AppliesGlobally(t *testing.T) {
+	r := routing.New()
+	r.Pattern("id", routing.PatternNumber)
+	r.Get("/users/{id}", okHandler)
+	r.Get("/posts/{id}", okHandler)
+
+	for _, path := range []string{"/users/abc", "/posts/abc"} {
+		if rr := do(t, r, http.MethodGet, path); rr.Code != http.StatusNotFound {
+			t.Errorf("%s: got %d want 404", path, rr.Code)
+		}
+	}
+	if rr := do(t, r, http.MethodGet, "/posts/7"); rr.Code != http.StatusOK {
+		t.Errorf("/posts/7: got %d want 200", rr.Code)
+	}
+}
+
+func TestPattern_RouteWhereWins(t *testing.T) {
+	r := routing.New()
+	r.Pattern("id", routing.PatternNumber)
+	r.Get("/tags/{id}", okHandler).WhereAlpha("id")
+
+	if rr := do(t, r, http.MethodGet, "/tags/go"); rr.Code != http.StatusOK {
+		t.Errorf("/tags/go: got %d want 200", rr.Code)
+	}
+	if rr := do(t, r, http.MethodGet, "/tags/1"); rr.Code != http.StatusNotFound {
+		t.Errorf("/tags/1: got %d want 404", rr.Code)
+	}
+}
+
+func TestPattern_InlineRegexWins(t *testing.T) {
+	r := routing.New()
+	r.Pattern("code", routing.PatternNumber)
+	r.Get("/codes/{code:[a-z]{3}}", okHandler)
+
+	if rr := do(t, r, http.MethodGet, "/codes/abc"); rr.Code != http.StatusOK {
+		t.Errorf("/codes/abc: got %d want 200", rr.Code)
+	}
+}
+
+// ── URL generation ────────────────────────────────────────────────────────────
+
+func TestWhere_URLGenerationValidates(t *testing.T) {
+	r := routing.New()
+	r.Get("/users/{id}", okHandler).Name("users.show").WhereNumber("id")
+
+	if p, err := r.Path("users.show", map[string]any{"id": 42}); err != nil || p != "/users/42" {
+		t.Errorf("got %q, %v want /users/42", p, err)
+	}
+	_, err := r.Path("users.show", map[string]any{"id": "abc"})
+	if err == nil || !strings.Contains(err.Error(), "must match") {
+		t.Errorf("expected constraint error, got %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package routing
+
+import (
+	"net/http"
+	"reflect"
+
+	"github.com/km-arc/go-laravel/framework/http/validation"
+)
+
+// ── API documentation ────────────────────────────────────────────────────────
+
+// RouteDoc is the documentation attached to a route, read by generators such
+// as the openapi package. Types are recorded from sample values, e.g.
+// Accepts(CreateUser{}) or Returns(201, &User{}).
+type RouteDoc struct {
+	Summary     string
+	Description string
+	Tags        []string
+	Rules       validation.Rules     // input rules, documented as body or query fields
+	Request     reflect.Type         // request body type
+	Responses   map[int]reflect.Type // status → body type (nil for no body)
+	Hidden      bool
+}
+
+// Summary sets a one-line summary for the API docs.
+func (rt *Route) Summary(summary string) *Route {
+	return rt.document(func(d *RouteDoc) { d.Summary = summary })
+}
+
+// Description sets a longer description for the API docs.
+func (rt *Route) Description(description string) *Route {
+	return rt.document(func(d *RouteDoc) { d.Description = description })
+}
+
+// Tags groups the route in the API docs.
+func (rt *Route) Tags(tags ...string) *Route {
+	return rt.document(func(d *RouteDoc) { d.Tags = append(d.Tags, tags...) })
+}
+
+// Rules documents the route's input with validation rules: query parameters
+// for GET, HEAD and DELETE, the request body otherwise. The rules are not
+// enforced; validate in the handler as usual.
+//
+//	r.Post("/users", store).Rules(validation.Rules{
+//	    "name":  "required|min:2|max:100",
+//	    "email": "required|email",
+//	})
+func (rt *Route) Rules(rules validation.Rules) *Route {
+	return rt.document(func(d *RouteDoc) { d.Rules = rules })
+}
+
+// Accepts documents the request body with the type of body.
+//
+//	r.Post("/users", store).Accepts(CreateUserRequest{})
+func (rt *Route) Accepts(body any) *Route {
+	return rt.document(func(d *RouteDoc) { d.Request = reflect.TypeOf(body) })
+}
+
+// Returns documents a response; pass nil for a response without a body.
+// Typed handlers returning (T, error) document 200 with T automatically.
+//
+//	r.Post("/users", store).Returns(http.StatusCreated, &User{})
+func (rt *Route) Returns(status int, body any) *Route {
+	return rt.document(func(d *RouteDoc) { d.Responses[status] = reflect.TypeOf(body) })
+}
+
+// Undocumented hides the route from generated API docs.
+func (rt *Route) Undocumented() *Route {
+	return rt.document(func(d *RouteDoc) { d.Hidden = true })
+}
+
+func (rt *Route) document(fn func(*RouteDoc)) *Route {
+	rt.routes.mu.Lock()
+	defer rt.routes.mu.Unlock()
+	if rt.doc.Responses == nil {
+		rt.doc.Responses = make(map[int]reflect.Type)
+	}
+	fn(&rt.doc)
+	return rt
+}
+
+// docFor returns a copy of the route's documentation (must hold mu).
+func docFor(rt *Route) RouteDoc {
+	doc := rt.doc
+	doc.Tags = append([]string(nil), rt.doc.Tags...)
+	doc.Responses = make(map[int]reflect.Type, len(rt.doc.Responses)+1)
+	for status, t := range rt.doc.Responses {
+		doc.Responses[status] = t
+	}
+	result := rt.result
+	if reg, ok := rt.routes.handlers[rt.handlerKey]; ok && rt.handlerKey != "" {
+		result = reg.result
+	}
+	if _, ok := doc.Responses[http.StatusOK]; !ok && result != nil {
+		doc.Responses[http.StatusOK] = result
+	}
+	return doc
+}
+
+// resultType returns the value type a typed handler returns, or nil.
+func resultType(h any) reflect.Type {
+	var t reflect.Type
+	switch h := h.(type) {
+	case ControllerAction:
+		t = reflect.ValueOf(h.Controller).MethodByName(h.Method).Type()
+	case http.Handler, func(http.ResponseWriter, *http.Request):
+		return nil
+	default:
+		t = reflect.TypeOf(h)
+	}
+	if t == nil || t.Kind() != reflect.Func || t.NumOut() == 0 {
+		return nil
+	}
+	if out := t.Out(0); out != errorType && out.Kind() != reflect.Interface {
+		return out
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package routing_test
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/http/validation"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── RouteDoc ──────────────────────────────────────────────────────────────────
+
+func TestRouteDoc_Attributes(t *testing.T) {
+	r := routing.New()
+	r.Post("/users", okHandler).Name("users.store").
+		Summary("Create").Description("Creates a user.").Tags("users", "admin").
+		Rules(validation.Rules{"name": "required"}).
+		Accepts(user{}).
+		Returns(http.StatusCreated, &user{}).
+		Returns(http.StatusNoContent, nil)
+
+	doc := r.List(routing.RouteFilter{Name: "users.store"})[0].Doc
+	if doc.Summary != "Create" || doc.Description != "Creates a user." || len(doc.Tags) != 2 {
+		t.Errorf("got %+v", doc)
+	}
+	if doc.Rules["name"] != "required" || doc.Request != reflect.TypeFor[user]() {
+		t.Errorf("input: got %v %v", doc.Rules, doc.Request)
+	}
+	if doc.Responses[http.StatusCreated] != reflect.TypeFor[*user]() {
+		t.Errorf("201: got %v", doc.Responses[http.StatusCreated])
+	}
+	if body, ok := doc.Responses[http.StatusNoContent]; !ok || body != nil {
+		t.Errorf("204: got %v, %v", body, ok)
+	}
+}
+
+func TestRouteDoc_TypedHandlerResult(t *testing.T) {
+	r := routing.New()
+	r.Get("/typed", func() (*user, error) { return nil, nil })
+	r.Get("/any", func() (any, error) { return nil, nil })
+	r.Get("/overridden", func() (*user, error) { return nil, nil }).Returns(http.StatusOK, []user{})
+
+	for uri, want := range map[string]reflect.Type{
+		"/typed":      reflect.TypeFor[*user](),
+		"/any":        nil,
+		"/overridden": reflect.TypeFor[[]user](),
+	} {
+		info := r.List(routing.RouteFilter{Path: uri})[0]
+		if got := info.Doc.Responses[http.StatusOK]; got != want {
+			t.Errorf("%s: got %v want %v", uri, got, want)
+		}
+	}
+}
+
+func TestRouteDoc_Undocumented(t *testing.T) {
+	r := routing.New()
+	r.Get("/health", okHandler).Undocumented()
+	if !r.List(routing.RouteFilter{})[0].Doc.Hidden {
+		t.Error("expected Hidden")
+	}
+}
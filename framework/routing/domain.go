@@ -0,0 +1,135 @@
+package routing
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ── Domain routing ───────────────────────────────────────────────────────────
+
+// Domain creates a group whose routes only match requests for the given
+// host. {param} segments capture parts of the host; read them with Param
+// like path parameters, and constrain them with Where or Pattern.
+//
+//	// Laravel: Route::domain('{account}.example.com')->group(fn)
+//	r.Domain("{account}.example.com", func(tenant *routing.Router) {
+//	    tenant.Get("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+//	        account := routing.Param(r, "account")
+//	    }).Name("tenant.users.show")
+//	})
+//
+// Domain routes are matched before routes without a domain, which answer
+// any host. URL generates absolute URLs on the route's domain.
+func (r *Router) Domain(pattern string, fn func(r *Router)) {
+	g := r.group.child()
+	g.domain = strings.ToLower(pattern)
+	fn(r.derive(g))
+}
+
+// fullDomain returns the innermost domain set on the group chain.
+func (g *group) fullDomain() string {
+	if g == nil {
+		return ""
+	}
+	if g.domain != "" {
+		return g.domain
+	}
+	return g.parent.fullDomain()
+}
+
+// hostRoutes holds the routes of one domain pattern, compiled to a mux.
+type hostRoutes struct {
+	host      *regexp.Regexp
+	params    []string
+	mux       *chi.Mux
+	fallbacks []fallbackRoute // Router.Fallback routes on this domain
+}
+
+// hostRouter dispatches to the first domain whose host pattern and routes
+// match the request, then to the routes without a domain. When only the
+// host matches, the domain answers if it knows the path under another
+// method (405) or has a fallback route, and the routes without a domain
+// don't match either.
+type hostRouter struct {
+	hosts    []*hostRoutes
+	fallback *chi.Mux
+}
+
+func (h *hostRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	host := requestHost(r)
+	path := routePath(r)
+	var (
+		near    *hostRoutes
+		nearHit []string
+	)
+	for _, hr := range h.hosts {
+		m := hr.host.FindStringSubmatch(host)
+		if m == nil {
+			continue
+		}
+		if hr.mux.Match(chi.NewRouteContext(), r.Method, path) {
+			hr.serve(w, r, m)
+			return
+		}
+		if near == nil && (len(hr.fallbacks) > 0 || len(allowedMethods(hr.mux, path)) > 0) {
+			near, nearHit = hr, m
+		}
+	}
+	if near != nil && !h.fallback.Match(chi.NewRouteContext(), r.Method, path) {
+		near.serve(w, r, nearHit)
+		return
+	}
+	h.fallback.ServeHTTP(w, r)
+}
+
+// serve hands the request to the domain's mux with the host parameters
+// from the submatches m.
+func (hr *hostRoutes) serve(w http.ResponseWriter, r *http.Request, m []string) {
+	rctx := chi.NewRouteContext()
+	rctx.Routes = hr.mux
+	for i, name := range hr.params {
+		rctx.URLParams.Add(name, m[hr.host.SubexpIndex(hostGroup(i))])
+	}
+	hr.mux.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx)))
+}
+
+// compileHost turns a constrained domain pattern into an anchored,
+// case-insensitive host regex and its parameter names. Parameters are named
+// groups (see hostGroup), so groups inside a constraint don't shift them.
+func compileHost(pattern string) (*regexp.Regexp, []string) {
+	var b strings.Builder
+	var params []string
+	b.WriteString("(?i)^")
+	for _, part := range splitPattern(pattern) {
+		switch {
+		case part.param == "":
+			b.WriteString(regexp.QuoteMeta(part.literal))
+		case part.regex != "":
+			b.WriteString("(?P<" + hostGroup(len(params)) + ">" + part.regex + ")")
+			params = append(params, part.param)
+		default:
+			b.WriteString("(?P<" + hostGroup(len(params)) + ">[^.]+)")
+			params = append(params, part.param)
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String()), params
+}
+
+// hostGroup names the regex group of the i-th host parameter.
+func hostGroup(i int) string { return "p" + strconv.Itoa(i) }
+
+// requestHost returns the lower-case request host without its port.
+func requestHost(r *http.Request) string {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
@@ -0,0 +1,161 @@
+package routing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func doHost(t *testing.T, router *routing.Router, method, host, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	req.Host = host
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func echoParam(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(routing.Param(r, name)))
+	}
+}
+
+func tenantRouter() *routing.Router {
+	r := routing.New()
+	r.Domain("{tenant}.example.com", func(tenant *routing.Router) {
+		tenant.Get("/", echoParam("tenant")).Name("tenant.home")
+		tenant.Get("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(routing.Param(r, "tenant") + ":" + routing.Param(r, "id")))
+		}).Name("tenant.users.show")
+	})
+	r.Get("/", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write([]byte("landing")) }).Name("home")
+	return r
+}
+
+// ── Matching ──────────────────────────────────────────────────────────────────
+
+func TestDomain_CapturesSubdomain(t *testing.T) {
+	r := tenantRouter()
+	if rr := doHost(t, r, http.MethodGet, "acme.example.com", "/users/7"); rr.Body.String() != "acme:7" {
+		t.Errorf("got %d %q want acme:7", rr.Code, rr.Body.String())
+	}
+	if rr := doHost(t, r, http.MethodGet, "Globex.Example.com:8080", "/"); rr.Body.String() != "globex" {
+		t.Errorf("host with port and case: got %q want globex", rr.Body.String())
+	}
+}
+
+func TestDomain_OtherHostsFallBack(t *testing.T) {
+	r := tenantRouter()
+	if rr := doHost(t, r, http.MethodGet, "example.com", "/"); rr.Body.String() != "landing" {
+		t.Errorf("apex: got %q want landing", rr.Body.String())
+	}
+	if rr := doHost(t, r, http.MethodGet, "a.b.example.com", "/"); rr.Body.String() != "landing" {
+		t.Errorf("nested subdomain: got %q want landing", rr.Body.String())
+	}
+	if rr := doHost(t, r, http.MethodGet, "example.com", "/users/7"); rr.Code != http.StatusNotFound {
+		t.Errorf("domain-only route on other host: got %d want 404", rr.Code)
+	}
+}
+
+func TestDomain_UnmatchedPathFallsThrough(t *testing.T) {
+	r := tenantRouter()
+	r.Get("/about", okHandler)
+	if rr := doHost(t, r, http.MethodGet, "acme.example.com", "/about"); rr.Code != http.StatusOK {
+		t.Errorf("got %d want 200 from the domainless route", rr.Code)
+	}
+}
+
+func TestDomain_Constraints(t *testing.T) {
+	r := routing.New()
+	r.Domain("{tenant}.example.com", func(tenant *routing.Router) {
+		tenant.Get("/", echoParam("tenant")).WhereAlpha("tenant")
+	})
+	if rr := doHost(t, r, http.MethodGet, "acme.example.com", "/"); rr.Body.String() != "acme" {
+		t.Errorf("got %q", rr.Body.String())
+	}
+	if rr := doHost(t, r, http.MethodGet, "acme1.example.com", "/"); rr.Code != http.StatusNotFound {
+		t.Errorf("constrained: got %d want 404", rr.Code)
+	}
+}
+
+func TestDomain_ConstraintGroupsKeepParameters(t *testing.T) {
+	r := routing.New()
+	r.Domain("{account}.{region}.example.com", func(d *routing.Router) {
+		d.Get("/", func(w http.ResponseWriter, req *http.Request) {
+			_, _ = w.Write([]byte(chi.URLParam(req, "account") + "/" + chi.URLParam(req, "region")))
+		}).Where("account", "(a|b)c")
+	})
+	if rr := doHost(t, r, http.MethodGet, "ac.eu.example.com", "/"); rr.Body.String() != "ac/eu" {
+		t.Errorf("got %q want %q", rr.Body.String(), "ac/eu")
+	}
+}
+
+func TestDomain_StaticHost(t *testing.T) {
+	r := routing.New()
+	r.Domain("admin.example.com", func(admin *routing.Router) {
+		admin.Get("/", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write([]byte("admin")) })
+	})
+	r.Get("/", okHandler)
+	if rr := doHost(t, r, http.MethodGet, "admin.example.com", "/"); rr.Body.String() != "admin" {
+		t.Errorf("got %q want admin", rr.Body.String())
+	}
+	if rr := doHost(t, r, http.MethodGet, "adminXexample.com", "/"); rr.Body.String() != "ok" {
+		t.Errorf("dots must be literal: got %q", rr.Body.String())
+	}
+}
+
+func TestDomain_ModelBinding(t *testing.T) {
+	r := routing.New()
+	r.Model("tenant", func(_ *http.Request, v string) (any, error) {
+		if v == "acme" {
+			return &user{Name: "Acme Inc"}, nil
+		}
+		return nil, routing.ErrModelNotFound
+	})
+	r.Domain("{tenant}.example.com", func(tenant *routing.Router) {
+		tenant.Get("/", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(routing.Bound[*user](r, "tenant").Name))
+		})
+	})
+	if rr := doHost(t, r, http.MethodGet, "acme.example.com", "/"); rr.Body.String() != "Acme Inc" {
+		t.Errorf("got %q", rr.Body.String())
+	}
+	if rr := doHost(t, r, http.MethodGet, "nope.example.com", "/"); rr.Code != http.StatusNotFound {
+		t.Errorf("got %d want 404", rr.Code)
+	}
+}
+
+// ── URL generation ────────────────────────────────────────────────────────────
+
+func TestDomain_URLGeneration(t *testing.T) {
+	r := tenantRouter()
+	r.SetBaseURL("https://example.com:8443")
+
+	u, err := r.URL("tenant.users.show", map[string]any{"tenant": "acme", "id": 7, "tab": "posts"})
+	if err != nil || u != "https://acme.example.com:8443/users/7?tab=posts" {
+		t.Errorf("URL: got %q, %v", u, err)
+	}
+	p, err := r.Path("tenant.users.show", map[string]any{"tenant": "acme", "id": 7})
+	if err != nil || p != "/users/7" {
+		t.Errorf("Path: got %q, %v", p, err)
+	}
+	if _, err := r.URL("tenant.home", nil); err == nil {
+		t.Error("expected missing tenant parameter error")
+	}
+	if u, _ := r.URL("home", nil); u != "https://example.com:8443/" {
+		t.Errorf("domainless: got %q", u)
+	}
+}
+
+func TestDomain_Listed(t *testing.T) {
+	info := tenantRouter().List(routing.RouteFilter{Name: "tenant.home"})[0]
+	if info.Domain != "{tenant}.example.com" || info.URI != "/" {
+		t.Errorf("got %+v", info)
+	}
+}
@@ -0,0 +1,139 @@
+package routing
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+)
+
+// ── Fallback & error handlers ────────────────────────────────────────────────
+
+// fallbackParam is the placeholder shown for fallback routes in Router.List.
+const fallbackParam = "{fallbackPlaceholder}"
+
+// Fallback registers the handler for requests no other route matches. It
+// accepts the same handlers as Match and runs the group's middleware; in a
+// Prefix group it only catches paths under the prefix. Fallback routes are
+// left out of the API docs.
+//
+//	// Laravel: Route::fallback(fn () => response()->view('errors.missing', [], 404))
+//	r.Fallback(func(w http.ResponseWriter, r *http.Request) {
+//	    views.ViewWithStatus(w, http.StatusNotFound, "errors/missing", nil)
+//	})
+func (r *Router) Fallback(h any) *Route {
+	rt := &Route{
+		methods:  []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "HEAD"},
+		uri:      joinPath(r.group.fullPrefix(), fallbackParam),
+		domain:   r.group.fullDomain(),
+		version:  r.group.fullVersion(),
+		fallback: true,
+		group:    r.group,
+		routes:   r.routes,
+	}
+	r.routes.setHandler(rt, h)
+	rt.doc.Hidden = true
+	return r.routes.add(rt)
+}
+
+// SetViews sets the engine used for HTML 404 and 405 pages: the views
+// "errors/404" and "errors/405" are rendered when they exist, with "status",
+// "message" and "path" as data. ViewServiceProvider sets it.
+func (r *Router) SetViews(views *gohttp.ViewEngine) {
+	r.routes.views.Store(views)
+}
+
+// fallbackRoute is a compiled fallback and the path prefix it answers,
+// e.g. "/api/" ("/" catches everything).
+type fallbackRoute struct {
+	prefix  string
+	handler http.Handler
+}
+
+// fallbackPrefix returns the path prefix a fallback route catches.
+func fallbackPrefix(rt *Route) string { return strings.TrimSuffix(rt.uri, fallbackParam) }
+
+// installErrorHandlers sets mux's 404 handler to the fallbacks, longest
+// prefix first, then the default not-found response, and its 405 handler
+// (must hold mu).
+func (c *routeCollection) installErrorHandlers(mux *chi.Mux, fallbacks []fallbackRoute) {
+	sort.SliceStable(fallbacks, func(i, j int) bool { return len(fallbacks[i].prefix) > len(fallbacks[j].prefix) })
+	mux.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		for _, f := range fallbacks {
+			if strings.HasPrefix(r.URL.Path+"/", f.prefix) {
+				f.handler.ServeHTTP(w, r)
+				return
+			}
+		}
+		c.respondStatus(w, r, http.StatusNotFound, "")
+	})
+	mux.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		allowed := allowedMethods(mux, routePath(r))
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		c.respondStatus(w, r, http.StatusMethodNotAllowed, fmt.Sprintf(
+			"The %s method is not supported for this route. Supported methods: %s.",
+			r.Method, strings.Join(allowed, ", ")))
+	})
+}
+
+// respondStatus answers JSON clients (Request.IsJSON) with the Response
+// envelope, and HTML clients with the "errors/{status}" view if there is
+// one, otherwise plain text.
+func (c *routeCollection) respondStatus(w http.ResponseWriter, r *http.Request, status int, message string) {
+	if gohttp.NewRequest(r).IsJSON() {
+		res := gohttp.NewResponse(w)
+		switch {
+		case message != "":
+			res.Error(status, message)
+		case status == http.StatusNotFound:
+			res.NotFound()
+		case status == http.StatusRequestEntityTooLarge:
+			res.ContentTooLarge()
+		case status == http.StatusServiceUnavailable:
+			res.ServiceUnavailable()
+		default:
+			res.Error(status, http.StatusText(status))
+		}
+		return
+	}
+	if message == "" {
+		message = http.StatusText(status)
+	}
+	name := fmt.Sprintf("errors/%d", status)
+	if views := c.views.Load(); views != nil && views.Exists(name) {
+		views.Request(r).ViewWithStatus(w, status, name, map[string]any{
+			"status": status, "message": message, "path": r.URL.Path,
+		})
+		return
+	}
+	http.Error(w, message, status)
+}
+
+// routeMethods are the methods probed for the Allow header.
+var routeMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete, http.MethodOptions,
+}
+
+// allowedMethods returns the methods mux routes for path.
+func allowedMethods(mux *chi.Mux, path string) []string {
+	var allowed []string
+	for _, m := range routeMethods {
+		if mux.Match(chi.NewRouteContext(), m, path) {
+			allowed = append(allowed, m)
+		}
+	}
+	return allowed
+}
+
+// routePath returns the path chi routes on.
+func routePath(r *http.Request) string {
+	if r.URL.RawPath != "" {
+		return r.URL.RawPath
+	}
+	return r.URL.Path
+}
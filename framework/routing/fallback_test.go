@@ -0,0 +1,182 @@
+package routing_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func doJSON(t *testing.T, router *routing.Router, method, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("Accept", "application/json")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func write(text string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write([]byte(text)) }
+}
+
+// ── Fallback ─────────────────────────────────────────────────────────────────
+
+func TestFallback_CatchesUnmatched(t *testing.T) {
+	r := routing.New()
+	r.Get("/users", okHandler)
+	r.Fallback(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("missing"))
+	})
+
+	if rr := do(t, r, http.MethodGet, "/users"); rr.Code != http.StatusOK {
+		t.Errorf("matched route: got %d want 200", rr.Code)
+	}
+	rr := do(t, r, http.MethodGet, "/nope/deep")
+	if rr.Code != http.StatusNotFound || rr.Body.String() != "missing" {
+		t.Errorf("got %d %q want 404 missing", rr.Code, rr.Body.String())
+	}
+}
+
+func TestFallback_PrefixGroup(t *testing.T) {
+	r := routing.New()
+	r.Prefix("/api", func(api *routing.Router) {
+		api.Fallback(write("api"))
+	})
+	r.Fallback(write("web"))
+
+	cases := map[string]string{"/api/missing": "api", "/api": "api", "/apix": "web", "/other": "web"}
+	for path, want := range cases {
+		if got := do(t, r, http.MethodGet, path).Body.String(); got != want {
+			t.Errorf("%s: got %q want %q", path, got, want)
+		}
+	}
+}
+
+func TestFallback_GroupMiddleware(t *testing.T) {
+	r := routing.New()
+	r.Group(func(g *routing.Router) {
+		g.Middleware(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("X-Group", "1")
+				next.ServeHTTP(w, req)
+			})
+		})
+		g.Fallback(okHandler)
+	})
+	if rr := do(t, r, http.MethodGet, "/missing"); rr.Header().Get("X-Group") != "1" {
+		t.Error("group middleware did not run on the fallback")
+	}
+}
+
+func TestFallback_HiddenFromDocs(t *testing.T) {
+	r := routing.New()
+	r.Fallback(okHandler)
+	routes := r.List(routing.RouteFilter{})
+	if len(routes) != 1 || routes[0].URI != "/{fallbackPlaceholder}" || !routes[0].Doc.Hidden {
+		t.Errorf("got %+v", routes)
+	}
+}
+
+// ── Default 404 / 405 ────────────────────────────────────────────────────────
+
+func TestNotFound_JSON(t *testing.T) {
+	r := routing.New()
+	rr := doJSON(t, r, http.MethodGet, "/missing")
+	var body map[string]string
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if rr.Code != http.StatusNotFound || body["message"] != "Not found." {
+		t.Errorf("got %d %v", rr.Code, body)
+	}
+}
+
+func TestNotFound_PlainText(t *testing.T) {
+	r := routing.New()
+	rr := do(t, r, http.MethodGet, "/missing")
+	if rr.Code != http.StatusNotFound || !strings.HasPrefix(rr.Header().Get("Content-Type"), "text/plain") {
+		t.Errorf("got %d %q", rr.Code, rr.Header().Get("Content-Type"))
+	}
+}
+
+func TestMethodNotAllowed_JSONWithAllow(t *testing.T) {
+	r := routing.New()
+	r.Get("/users", okHandler)
+	r.Post("/users", okHandler)
+
+	rr := doJSON(t, r, http.MethodDelete, "/users")
+	if rr.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("got %d want 405", rr.Code)
+	}
+	if got := rr.Header().Get("Allow"); got != "GET, POST" {
+		t.Errorf("Allow: got %q", got)
+	}
+	var body map[string]string
+	_ = json.Unmarshal(rr.Body.Bytes(), &body)
+	if !strings.Contains(body["message"], "The DELETE method is not supported") {
+		t.Errorf("message: %q", body["message"])
+	}
+}
+
+func TestErrorPages_Views(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "errors"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	page := `<h1>{{.status}}</h1><p>{{.path}}</p>`
+	if err := os.WriteFile(filepath.Join(dir, "errors", "404.html"), []byte(page), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := routing.New()
+	r.Get("/users", okHandler)
+	r.SetViews(gohttp.NewViewEngine(dir, ".html"))
+
+	rr := do(t, r, http.MethodGet, "/missing")
+	if rr.Code != http.StatusNotFound || rr.Body.String() != "<h1>404</h1><p>/missing</p>" {
+		t.Errorf("404 view: got %d %q", rr.Code, rr.Body.String())
+	}
+	// No errors/405 view: plain text.
+	if rr := do(t, r, http.MethodPost, "/users"); rr.Code != http.StatusMethodNotAllowed || strings.Contains(rr.Body.String(), "<h1>") {
+		t.Errorf("405 without view: got %d %q", rr.Code, rr.Body.String())
+	}
+	// JSON clients never get the view.
+	if rr := doJSON(t, r, http.MethodGet, "/missing"); strings.Contains(rr.Body.String(), "<h1>") {
+		t.Errorf("JSON client got the view: %q", rr.Body.String())
+	}
+}
+
+// ── Domains ──────────────────────────────────────────────────────────────────
+
+func TestDomain_MethodNotAllowed(t *testing.T) {
+	r := tenantRouter()
+	rr := doHost(t, r, http.MethodPost, "acme.example.com", "/users/7")
+	if rr.Code != http.StatusMethodNotAllowed || rr.Header().Get("Allow") != "GET" {
+		t.Errorf("got %d Allow %q", rr.Code, rr.Header().Get("Allow"))
+	}
+}
+
+func TestDomain_Fallback(t *testing.T) {
+	r := tenantRouter()
+	r.Domain("{tenant}.example.com", func(tenant *routing.Router) {
+		tenant.Fallback(echoParam("tenant"))
+	})
+	r.Fallback(write("web"))
+
+	if got := doHost(t, r, http.MethodGet, "acme.example.com", "/missing").Body.String(); got != "acme" {
+		t.Errorf("tenant fallback: got %q want acme", got)
+	}
+	if got := doHost(t, r, http.MethodGet, "example.com", "/missing").Body.String(); got != "web" {
+		t.Errorf("default fallback: got %q want web", got)
+	}
+}
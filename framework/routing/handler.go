@@ -0,0 +1,238 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/km-arc/go-laravel/framework/container"
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+	"github.com/km-arc/go-laravel/framework/http/validation"
+)
+
+// ── Handlers ─────────────────────────────────────────────────────────────────
+
+// HTTPError is an error with an HTTP status. Return it from a handler to
+// answer with that status — Laravel's HttpException.
+type HTTPError struct {
+	Status  int
+	Message string
+}
+
+func (e *HTTPError) Error() string { return fmt.Sprintf("%d %s", e.Status, e.Message) }
+
+// Abort returns an *HTTPError; the message defaults to the status text.
+//
+//	// Laravel: abort(403, 'Not your post.')
+//	return nil, routing.Abort(http.StatusForbidden, "Not your post.")
+func Abort(status int, message ...string) error {
+	msg := http.StatusText(status)
+	if len(message) > 0 {
+		msg = message[0]
+	}
+	return &HTTPError{Status: status, Message: msg}
+}
+
+// ControllerAction references a controller method by name; see Action.
+type ControllerAction struct {
+	Controller any
+	Method     string
+}
+
+// Action references a controller method as a route handler. The method may
+// use any handler signature Match accepts. It panics if the method does not
+// exist.
+//
+//	// Laravel: Route::get('/users/{id}', [UserController::class, 'show'])
+//	r.Get("/users/{id}", routing.Action(&UserController{}, "Show"))
+func Action(controller any, method string) ControllerAction {
+	if !reflect.ValueOf(controller).MethodByName(method).IsValid() {
+		panic(fmt.Sprintf("routing: %T has no method %s", controller, method))
+	}
+	return ControllerAction{Controller: controller, Method: method}
+}
+
+// SetContainer sets the container typed handlers resolve extra parameters
+// from. RoutingServiceProvider sets it to the application container.
+func (r *Router) SetContainer(c *container.Container) {
+	r.routes.container.Store(c)
+}
+
+var (
+	errorType   = reflect.TypeFor[error]()
+	writerType  = reflect.TypeFor[http.ResponseWriter]()
+	requestType = reflect.TypeFor[*http.Request]()
+	contextType = reflect.TypeFor[context.Context]()
+	gohttpReq   = reflect.TypeFor[*gohttp.Request]()
+	gohttpRes   = reflect.TypeFor[*gohttp.Response]()
+)
+
+// handlerFor adapts a handler accepted by Match to an http.Handler, and
+// names it for Router.List. It panics on unsupported signatures, so mistakes
+// surface at registration rather than on the first request.
+func (c *routeCollection) handlerFor(h any) (http.Handler, string) {
+	switch h := h.(type) {
+	case ControllerAction:
+		fn := reflect.ValueOf(h.Controller).MethodByName(h.Method)
+		name := strings.TrimPrefix(fmt.Sprintf("%T@%s", h.Controller, h.Method), "*")
+		return c.typed(fn, name), name
+	case http.HandlerFunc:
+		return h, funcName(h)
+	case func(http.ResponseWriter, *http.Request):
+		return http.HandlerFunc(h), funcName(h)
+	case http.Handler:
+		return h, strings.TrimPrefix(fmt.Sprintf("%T", h), "*")
+	}
+	fn := reflect.ValueOf(h)
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		panic(fmt.Sprintf("routing: unsupported handler %T", h))
+	}
+	name := funcName(h)
+	return c.typed(fn, name), name
+}
+
+// typed wraps a function whose parameters are resolved per request:
+// http.ResponseWriter, *http.Request, context.Context, *gohttp.Request and
+// *gohttp.Response are supplied directly, anything else is made from the
+// container by its package-qualified type name (see container.TypeKey).
+// Router.Validate reports parameters the container cannot make.
+//
+// It may return nothing, an error, a value, or a value and an error. A
+// non-nil value is sent as JSON with status 200; errors are mapped by
+// respondError.
+func (c *routeCollection) typed(fn reflect.Value, name string) http.Handler {
+	t := fn.Type()
+	if t.NumOut() > 2 || (t.NumOut() == 2 && t.Out(1) != errorType) {
+		panic(fmt.Sprintf("routing: handler %s must return (), (error), (T) or (T, error)", name))
+	}
+	if t.IsVariadic() {
+		panic(fmt.Sprintf("routing: handler %s must not be variadic", name))
+	}
+	if t.NumIn() == 2 && t.In(0) == writerType && t.In(1) == requestType && t.NumOut() == 0 {
+		// Plain method value: skip reflection on every request.
+		return http.HandlerFunc(fn.Interface().(func(http.ResponseWriter, *http.Request)))
+	}
+
+	return &typedHandler{routes: c, fn: fn, name: name}
+}
+
+// typedHandler is a handler made by typed.
+type typedHandler struct {
+	routes *routeCollection
+	fn     reflect.Value
+	name   string
+}
+
+func (h *typedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t := h.fn.Type()
+	args := make([]reflect.Value, t.NumIn())
+	for i := range args {
+		args[i] = h.routes.argument(t.In(i), w, r, h.name)
+	}
+	out := h.fn.Call(args)
+
+	var value any
+	var err error
+	switch {
+	case len(out) == 2:
+		value, err = out[0].Interface(), asError(out[1])
+	case len(out) == 1 && t.Out(0) == errorType:
+		err = asError(out[0])
+	case len(out) == 1:
+		value = out[0].Interface()
+	}
+	if err != nil {
+		respondError(w, err)
+		return
+	}
+	if !isNil(value) {
+		gohttp.NewResponse(w).JSON(http.StatusOK, value)
+	}
+}
+
+// unbound returns the container keys of parameters the container cannot
+// make; argument panics on them, so Validate reports them up front.
+func (h *typedHandler) unbound() []string {
+	app := h.routes.container.Load()
+	var keys []string
+	t := h.fn.Type()
+	for i := range t.NumIn() {
+		switch in := t.In(i); in {
+		case writerType, requestType, contextType, gohttpReq, gohttpRes:
+		default:
+			if key := typeKey(in); app == nil || !app.Bound(key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// argument supplies one handler parameter of type t.
+func (c *routeCollection) argument(t reflect.Type, w http.ResponseWriter, r *http.Request, name string) reflect.Value {
+	switch t {
+	case writerType:
+		return reflect.ValueOf(w).Convert(writerType)
+	case requestType:
+		return reflect.ValueOf(r)
+	case contextType:
+		return reflect.ValueOf(r.Context()).Convert(contextType)
+	case gohttpReq:
+		return reflect.ValueOf(gohttp.NewRequest(r))
+	case gohttpRes:
+		return reflect.ValueOf(gohttp.NewResponse(w).WithRequest(r))
+	}
+
+	app := c.container.Load()
+	key := typeKey(t)
+	if app == nil || !app.Bound(key) {
+		panic(fmt.Sprintf("routing: handler %s needs %s, which is not bound in the container", name, key))
+	}
+	made := app.Make(key)
+	v := reflect.ValueOf(made)
+	if !v.IsValid() || !v.Type().AssignableTo(t) {
+		panic(fmt.Sprintf("routing: handler %s needs %s, container made %T", name, t, made))
+	}
+	arg := reflect.New(t).Elem()
+	arg.Set(v)
+	return arg
+}
+
+// respondError maps a handler error to a response: *HTTPError to its
+// status, ErrModelNotFound to 404, *validation.Errors to 422 and anything
+// else to 500.
+func respondError(w http.ResponseWriter, err error) {
+	res := gohttp.NewResponse(w)
+	var httpErr *HTTPError
+	var invalid *validation.Errors
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &httpErr):
+		res.Error(httpErr.Status, httpErr.Message)
+	case errors.Is(err, ErrModelNotFound):
+		res.NotFound()
+	case errors.As(err, &invalid):
+		res.ValidationError(invalid)
+	case errors.As(err, &tooLarge):
+		res.ContentTooLarge()
+	default:
+		res.ServerError()
+	}
+}
+
+func asError(v reflect.Value) error {
+	err, _ := v.Interface().(error)
+	return err
+}
+
+// typeKey mirrors container.TypeKey for a reflect.Type, so interface
+// parameters resolve too.
+func typeKey(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
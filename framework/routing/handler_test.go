@@ -0,0 +1,193 @@
+package routing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/container"
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+	"github.com/km-arc/go-laravel/framework/http/validation"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+type greeter struct{ greeting string }
+
+type clock interface{ Now() string }
+
+type fixedClock struct{}
+
+func (fixedClock) Now() string { return "noon" }
+
+type userController struct{ greeter *greeter }
+
+func (c *userController) Show(req *gohttp.Request) (map[string]string, error) {
+	if req.RouteParam("id") == "0" {
+		return nil, routing.ErrModelNotFound
+	}
+	return map[string]string{"id": req.RouteParam("id")}, nil
+}
+
+func (c *userController) Plain(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte("plain"))
+}
+
+func containerRouter() *routing.Router {
+	app := container.New()
+	app.Instance(container.TypeKey(&greeter{}), &greeter{greeting: "hello"})
+	app.Instance(container.TypeKey((*clock)(nil)), fixedClock{})
+	r := routing.New()
+	r.SetContainer(app)
+	return r
+}
+
+// ── Signatures ────────────────────────────────────────────────────────────────
+
+func TestTyped_RequestResponse(t *testing.T) {
+	r := routing.New()
+	r.Get("/users/{id}", func(req *gohttp.Request, res *gohttp.Response) {
+		res.Success(req.RouteParam("id"))
+	})
+	rr := do(t, r, http.MethodGet, "/users/7")
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"data":"7"`) {
+		t.Errorf("got %d %s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestTyped_ReturnValueIsJSON(t *testing.T) {
+	r := routing.New()
+	r.Get("/ping", func(ctx context.Context, req *http.Request) (any, error) {
+		if ctx != req.Context() {
+			t.Error("context is not the request context")
+		}
+		return map[string]bool{"pong": true}, nil
+	})
+	rr := do(t, r, http.MethodGet, "/ping")
+	if rr.Code != http.StatusOK || strings.TrimSpace(rr.Body.String()) != `{"pong":true}` {
+		t.Errorf("got %d %s", rr.Code, rr.Body.String())
+	}
+	if ct := rr.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type: got %q", ct)
+	}
+}
+
+func TestTyped_ResolvesFromContainer(t *testing.T) {
+	r := containerRouter()
+	r.Get("/hi", func(g *greeter, c clock) string { return g.greeting + " at " + c.Now() })
+
+	rr := do(t, r, http.MethodGet, "/hi")
+	if rr.Code != http.StatusOK || strings.TrimSpace(rr.Body.String()) != `"hello at noon"` {
+		t.Errorf("got %d %s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestTyped_UnboundParameterIs500(t *testing.T) {
+	r := routing.New()
+	r.Get("/hi", func(g *greeter) string { return g.greeting })
+	if rr := do(t, r, http.MethodGet, "/hi"); rr.Code != http.StatusInternalServerError {
+		t.Errorf("got %d want 500", rr.Code)
+	}
+}
+
+func TestTyped_ValidateReportsUnboundParameter(t *testing.T) {
+	r := containerRouter()
+	r.Get("/hi", func(g *greeter, c clock) string { return g.greeting })
+	if err := r.Validate(); err != nil {
+		t.Errorf("bound parameters: %v", err)
+	}
+
+	r = routing.New()
+	r.RegisterHandler("hi", func(g *greeter) string { return g.greeting })
+	r.Get("/hi", "hi")
+	err := r.Validate()
+	if err == nil || !strings.Contains(err.Error(), "greeter") || !strings.Contains(err.Error(), "GET /hi") {
+		t.Errorf("got %v, want the unbound greeter reported", err)
+	}
+}
+
+func TestTyped_UnsupportedSignaturePanics(t *testing.T) {
+	for name, h := range map[string]any{
+		"not a func":       42,
+		"three results":    func() (int, int, error) { return 0, 0, nil },
+		"second not error": func() (int, string) { return 0, "" },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			routing.New().Get("/", h)
+		}()
+	}
+}
+
+// ── Errors ────────────────────────────────────────────────────────────────────
+
+func TestTyped_ErrorMapping(t *testing.T) {
+	bag := validation.Make(map[string]string{}, validation.Rules{"name": "required"})
+	bag.Fails()
+
+	cases := map[string]struct {
+		err  error
+		code int
+	}{
+		"abort":      {routing.Abort(http.StatusForbidden, "Not yours."), http.StatusForbidden},
+		"wrapped":    {errors.Join(errors.New("ctx"), routing.Abort(http.StatusConflict)), http.StatusConflict},
+		"not found":  {routing.ErrModelNotFound, http.StatusNotFound},
+		"validation": {bag.Errors(), http.StatusUnprocessableEntity},
+		"other":      {errors.New("boom"), http.StatusInternalServerError},
+	}
+	for name, c := range cases {
+		r := routing.New()
+		r.Post("/x", func() error { return c.err })
+		if rr := do(t, r, http.MethodPost, "/x"); rr.Code != c.code {
+			t.Errorf("%s: got %d want %d", name, rr.Code, c.code)
+		}
+	}
+}
+
+func TestAbort_DefaultMessage(t *testing.T) {
+	r := routing.New()
+	r.Get("/x", func() (any, error) { return nil, routing.Abort(http.StatusForbidden) })
+	rr := do(t, r, http.MethodGet, "/x")
+	if !strings.Contains(rr.Body.String(), "Forbidden") {
+		t.Errorf("got %s", rr.Body.String())
+	}
+}
+
+// ── Action ────────────────────────────────────────────────────────────────────
+
+func TestAction_ControllerMethod(t *testing.T) {
+	r := routing.New()
+	r.Get("/users/{id}", routing.Action(&userController{}, "Show")).Name("users.show")
+	r.Get("/plain", routing.Action(&userController{}, "Plain"))
+
+	if rr := do(t, r, http.MethodGet, "/users/5"); rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"id":"5"`) {
+		t.Errorf("show: got %d %s", rr.Code, rr.Body.String())
+	}
+	if rr := do(t, r, http.MethodGet, "/users/0"); rr.Code != http.StatusNotFound {
+		t.Errorf("missing: got %d want 404", rr.Code)
+	}
+	if rr := do(t, r, http.MethodGet, "/plain"); rr.Body.String() != "plain" {
+		t.Errorf("plain: got %q", rr.Body.String())
+	}
+
+	info := r.List(routing.RouteFilter{Name: "users.show"})[0]
+	if info.Action != "routing_test.userController@Show" {
+		t.Errorf("action: got %q", info.Action)
+	}
+}
+
+func TestAction_MissingMethodPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for missing method")
+		}
+	}()
+	routing.Action(&userController{}, "Nope")
+}
@@ -0,0 +1,203 @@
+package routing
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+)
+
+// ── Timeouts & body limits ───────────────────────────────────────────────────
+
+// Timeout gives the route's handler d to respond. The request context is
+// cancelled at the deadline and the client gets a 503; the handler's own
+// output is buffered until it returns, so streaming responses should not
+// use a timeout. It overrides any group Timeout.
+//
+//	r.Get("/reports", reports).Timeout(5 * time.Second)
+func (rt *Route) Timeout(d time.Duration) *Route {
+	rt.routes.mu.Lock()
+	defer rt.routes.mu.Unlock()
+	rt.timeout = d
+	rt.routes.mux.Store(nil)
+	return rt
+}
+
+// MaxBody limits the request body to n bytes. Larger bodies get a 413 —
+// up front when Content-Length says so, otherwise as soon as the handler
+// reads past the limit (see gohttp.LimitBody). It overrides any group
+// MaxBody.
+//
+//	// Laravel: post_max_size
+//	r.Post("/avatars", upload).MaxBody(10 << 20)
+func (rt *Route) MaxBody(n int64) *Route {
+	rt.routes.mu.Lock()
+	defer rt.routes.mu.Unlock()
+	rt.maxBody = n
+	rt.routes.mux.Store(nil)
+	return rt
+}
+
+// Timeout sets the default Route.Timeout for the router's routes. On the
+// root router it applies to every route.
+//
+//	r.Prefix("/api", func(api *routing.Router) {
+//		api.Timeout(10 * time.Second)
+//		...
+//	})
+func (r *Router) Timeout(d time.Duration) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.group.timeout = d
+	r.routes.mux.Store(nil)
+}
+
+// MaxBody sets the default Route.MaxBody for the router's routes. On the
+// root router it applies to every route.
+func (r *Router) MaxBody(n int64) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.group.maxBody = n
+	r.routes.mux.Store(nil)
+}
+
+// fullTimeout returns the innermost group timeout.
+func (g *group) fullTimeout() time.Duration {
+	for ; g != nil; g = g.parent {
+		if g.timeout > 0 {
+			return g.timeout
+		}
+	}
+	return 0
+}
+
+// fullMaxBody returns the innermost group body limit.
+func (g *group) fullMaxBody() int64 {
+	for ; g != nil; g = g.parent {
+		if g.maxBody > 0 {
+			return g.maxBody
+		}
+	}
+	return 0
+}
+
+// limits returns rt's timeout and body limit, 0 meaning none.
+func (c *routeCollection) limits(rt *Route) (time.Duration, int64) {
+	timeout, maxBody := rt.timeout, rt.maxBody
+	if timeout <= 0 {
+		timeout = rt.group.fullTimeout()
+	}
+	if maxBody <= 0 {
+		maxBody = rt.group.fullMaxBody()
+	}
+	return timeout, maxBody
+}
+
+// withLimits wraps a route's handler — its middleware included — with its
+// body limit and, outside that, its timeout. Root middleware runs first.
+func (c *routeCollection) withLimits(rt *Route, h http.Handler) http.Handler {
+	timeout, maxBody := c.limits(rt)
+	if maxBody > 0 {
+		h = c.limitBody(maxBody, h)
+	}
+	if timeout > 0 {
+		h = c.timeoutHandler(timeout, h)
+	}
+	return h
+}
+
+// limitBody rejects bodies over n bytes.
+func (c *routeCollection) limitBody(n int64, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > n {
+			c.respondStatus(w, r, http.StatusRequestEntityTooLarge, "")
+			return
+		}
+		next.ServeHTTP(w, gohttp.LimitBody(w, r, n))
+	})
+}
+
+// timeoutHandler runs next with a deadline, like http.TimeoutHandler but
+// answering through respondStatus, and not at all when the request is
+// cancelled first. Panics are re-raised on the serving
+// goroutine so recovery middleware still sees them.
+func (c *routeCollection) timeoutHandler(d time.Duration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), d)
+		defer cancel()
+		r = r.WithContext(ctx)
+
+		tw := &timeoutWriter{header: make(http.Header)}
+		done := make(chan struct{})
+		panicked := make(chan any, 1)
+		go func() {
+			defer func() {
+				if p := recover(); p != nil {
+					panicked <- p
+				}
+			}()
+			next.ServeHTTP(tw, r)
+			close(done)
+		}()
+
+		select {
+		case p := <-panicked:
+			panic(p)
+		case <-done:
+			tw.mu.Lock()
+			defer tw.mu.Unlock()
+			for k, v := range tw.header {
+				w.Header()[k] = v
+			}
+			if tw.status == 0 {
+				tw.status = http.StatusOK
+			}
+			w.WriteHeader(tw.status)
+			w.Write(tw.body.Bytes())
+		case <-ctx.Done():
+			tw.mu.Lock()
+			defer tw.mu.Unlock()
+			tw.timedOut = true
+			// A client that went away, or a cancelled parent context, gets
+			// no response; only the deadline is a timeout.
+			if ctx.Err() == context.DeadlineExceeded {
+				c.respondStatus(w, r, http.StatusServiceUnavailable, "")
+			}
+		}
+	})
+}
+
+// timeoutWriter buffers a response until the handler returns in time.
+type timeoutWriter struct {
+	mu       sync.Mutex
+	header   http.Header
+	body     bytes.Buffer
+	status   int
+	timedOut bool
+}
+
+func (tw *timeoutWriter) Header() http.Header { return tw.header }
+
+func (tw *timeoutWriter) Write(p []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if tw.status == 0 {
+		tw.status = http.StatusOK
+	}
+	return tw.body.Write(p)
+}
+
+func (tw *timeoutWriter) WriteHeader(status int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut || tw.status != 0 {
+		return
+	}
+	tw.status = status
+}
@@ -0,0 +1,180 @@
+package routing_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func slow(w http.ResponseWriter, r *http.Request) {
+	select {
+	case <-r.Context().Done():
+	case <-time.After(100 * time.Millisecond):
+		_, _ = w.Write([]byte("too late"))
+	}
+}
+
+func post(t *testing.T, router *routing.Router, path string, body io.Reader) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, body)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+// ── Timeout ──────────────────────────────────────────────────────────────────
+
+func TestTimeout_Route(t *testing.T) {
+	r := routing.New()
+	r.Get("/slow", slow).Timeout(20 * time.Millisecond)
+	r.Get("/fast", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Done", "yes")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("done"))
+	}).Timeout(time.Second)
+
+	rr := doJSON(t, r, http.MethodGet, "/slow")
+	if rr.Code != http.StatusServiceUnavailable || !strings.Contains(rr.Body.String(), "Service Unavailable.") {
+		t.Errorf("slow: got %d %q", rr.Code, rr.Body.String())
+	}
+	rr = do(t, r, http.MethodGet, "/fast")
+	if rr.Code != http.StatusCreated || rr.Body.String() != "done" || rr.Header().Get("X-Done") != "yes" {
+		t.Errorf("fast: got %d %q %v", rr.Code, rr.Body.String(), rr.Header())
+	}
+}
+
+func TestTimeout_CancelledRequestGetsNoResponse(t *testing.T) {
+	r := routing.New()
+	r.Get("/slow", slow).Timeout(time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/slow", nil).WithContext(ctx)
+	rr := httptest.NewRecorder()
+	time.AfterFunc(10*time.Millisecond, cancel)
+	r.ServeHTTP(rr, req)
+
+	if rr.Body.Len() != 0 || rr.Code == http.StatusServiceUnavailable {
+		t.Errorf("cancelled: got %d %q, want no response", rr.Code, rr.Body.String())
+	}
+}
+
+func TestTimeout_GroupAndOverride(t *testing.T) {
+	r := routing.New()
+	r.Prefix("/api", func(api *routing.Router) {
+		api.Timeout(20 * time.Millisecond)
+		api.Get("/slow", slow)
+		api.Get("/patient", slow).Timeout(2 * time.Second)
+	})
+	r.Get("/slow", slow)
+
+	if rr := do(t, r, http.MethodGet, "/api/slow"); rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("group: got %d want 503", rr.Code)
+	}
+	if got := do(t, r, http.MethodGet, "/api/patient").Body.String(); got != "too late" {
+		t.Errorf("override: got %q", got)
+	}
+	if got := do(t, r, http.MethodGet, "/slow").Body.String(); got != "too late" {
+		t.Errorf("outside group: got %q", got)
+	}
+}
+
+func TestTimeout_PanicReachesRecovery(t *testing.T) {
+	r := routing.New()
+	r.Middleware(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if recover() != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}()
+			next.ServeHTTP(w, r)
+		})
+	})
+	r.Get("/boom", func() { panic("boom") }).Timeout(time.Second)
+	if rr := do(t, r, http.MethodGet, "/boom"); rr.Code != http.StatusInternalServerError {
+		t.Errorf("got %d want 500", rr.Code)
+	}
+}
+
+// ── MaxBody ──────────────────────────────────────────────────────────────────
+
+func TestMaxBody_ContentLength(t *testing.T) {
+	r := routing.New()
+	called := false
+	r.Post("/upload", func(w http.ResponseWriter, _ *http.Request) { called = true }).MaxBody(4)
+
+	rr := post(t, r, "/upload", strings.NewReader(`{"too":"long"}`))
+	if rr.Code != http.StatusRequestEntityTooLarge || called {
+		t.Errorf("got %d, handler called %v", rr.Code, called)
+	}
+	if rr := post(t, r, "/upload", strings.NewReader(`{}`)); rr.Code != http.StatusOK {
+		t.Errorf("small body: got %d", rr.Code)
+	}
+}
+
+func TestMaxBody_StreamedBodyFailsBind(t *testing.T) {
+	r := routing.New()
+	r.MaxBody(8)
+	r.Post("/users", func(req *gohttp.Request) (map[string]string, error) {
+		var v map[string]string
+		return v, req.Bind(&v)
+	})
+	// io.MultiReader hides the length, so only the read can fail.
+	rr := post(t, r, "/users", io.MultiReader(strings.NewReader(`{"name":"Taylor Otwell"}`)))
+	if rr.Code != http.StatusRequestEntityTooLarge || !strings.Contains(rr.Body.String(), "Content Too Large.") {
+		t.Errorf("got %d %q", rr.Code, rr.Body.String())
+	}
+}
+
+func TestMaxBody_RouteOverridesGlobal(t *testing.T) {
+	r := routing.New()
+	r.MaxBody(4)
+	r.Post("/small", okHandler)
+	r.Post("/large", okHandler).MaxBody(1 << 10)
+
+	if rr := post(t, r, "/small", strings.NewReader("hello")); rr.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("small: got %d want 413", rr.Code)
+	}
+	if rr := post(t, r, "/large", strings.NewReader("hello")); rr.Code != http.StatusOK {
+		t.Errorf("large: got %d want 200", rr.Code)
+	}
+}
+
+// ── Cache ────────────────────────────────────────────────────────────────────
+
+func TestLimits_Cached(t *testing.T) {
+	src := routing.New()
+	src.RegisterHandler("slow", slow)
+	src.RegisterHandler("ok", okHandler)
+	src.Timeout(20 * time.Millisecond)
+	src.Get("/slow", "slow")
+	src.Post("/upload", "ok").MaxBody(4)
+	var buf bytes.Buffer
+	if err := src.Cache(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	r := routing.New()
+	r.RegisterHandler("slow", slow)
+	r.RegisterHandler("ok", okHandler)
+	if err := r.LoadCache(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if rr := do(t, r, http.MethodGet, "/slow"); rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("timeout: got %d want 503", rr.Code)
+	}
+	if rr := post(t, r, "/upload", strings.NewReader("hello")); rr.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("max body: got %d want 413", rr.Code)
+	}
+}
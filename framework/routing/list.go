@@ -0,0 +1,138 @@
+package routing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// ── Route listing ────────────────────────────────────────────────────────────
+
+// RouteInfo describes a registered route — one row of `route:list`.
+type RouteInfo struct {
+	Methods    []string `json:"methods"`
+	Domain     string   `json:"domain"`
+	URI        string   `json:"uri"`
+	Name       string   `json:"name"`
+	Action     string   `json:"action"`
+	Middleware []string `json:"middleware"`
+
+	// Pattern is URI with every parameter constraint applied inline, e.g.
+	// "/users/{id:(?:[0-9]+)}" (see Route.Where).
+	Pattern string `json:"-"`
+	// Segments is Pattern split into literals and parameters.
+	Segments []PathSegment `json:"-"`
+	Doc      RouteDoc      `json:"-"`
+}
+
+// PathSegment is a literal run or a parameter of a route pattern:
+// "/users/{id:[0-9]+}" is the literal "/users/" then the parameter "id"
+// with regex "[0-9]+".
+type PathSegment struct {
+	Literal string // "" for parameters
+	Param   string // parameter name, "*" for a trailing wildcard
+	Regex   string // the parameter's constraint, if any
+}
+
+// RouteFilter narrows Router.List. Each non-empty field must be contained in
+// the route's method, name or URI; methods match case-insensitively.
+//
+//	// Laravel: php artisan route:list --method=GET --name=users --path=api
+//	r.List(routing.RouteFilter{Method: "GET", Name: "users", Path: "api"})
+type RouteFilter struct {
+	Method string
+	Name   string
+	Path   string
+}
+
+// List returns the registered routes sorted by URI, including those added
+// through groups, prefixes and resources. Middleware lists the route's group
+// and route middleware in the order it runs; global middleware installed on
+// the root router is not repeated per route.
+func (r *Router) List(filter RouteFilter) []RouteInfo {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+
+	var infos []RouteInfo
+	for _, rt := range r.routes.routes {
+		info := RouteInfo{
+			Methods:    append([]string(nil), rt.methods...),
+			Domain:     rt.domain,
+			URI:        rt.uri,
+			Name:       rt.name,
+			Action:     rt.action,
+			Middleware: []string{},
+			Pattern:    r.routes.constrained(rt),
+			Doc:        docFor(rt),
+		}
+		for _, part := range splitPattern(info.Pattern) {
+			info.Segments = append(info.Segments, PathSegment{Literal: part.literal, Param: part.param, Regex: part.regex})
+		}
+		for _, e := range r.routes.middlewareEntries(rt) {
+			name := e.name
+			if name == "" {
+				name = funcName(e.fn)
+			}
+			info.Middleware = append(info.Middleware, name)
+		}
+		if filter.matches(info) {
+			infos = append(infos, info)
+		}
+	}
+	sort.SliceStable(infos, func(i, j int) bool { return infos[i].URI < infos[j].URI })
+	return infos
+}
+
+func (f RouteFilter) matches(info RouteInfo) bool {
+	if f.Method != "" && !strings.Contains(strings.Join(info.Methods, "|"), strings.ToUpper(f.Method)) {
+		return false
+	}
+	if f.Name != "" && !strings.Contains(info.Name, f.Name) {
+		return false
+	}
+	return f.Path == "" || strings.Contains(info.Domain+info.URI, f.Path)
+}
+
+// WriteRouteTable writes routes as an aligned table.
+//
+//	METHOD    URI          NAME        ACTION                  MIDDLEWARE
+//	GET       /users/{id}  users.show  main.UserController@Show  auth
+func WriteRouteTable(w io.Writer, routes []RouteInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "METHOD\tURI\tNAME\tACTION\tMIDDLEWARE")
+	for _, rt := range routes {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", strings.Join(rt.Methods, "|"),
+			rt.Domain+rt.URI, rt.Name, rt.Action, strings.Join(rt.Middleware, ", "))
+	}
+	fmt.Fprintf(tw, "\nShowing [%d] routes\n", len(routes))
+	return tw.Flush()
+}
+
+// WriteRouteJSON writes routes as a JSON array — Laravel: route:list --json.
+func WriteRouteJSON(w io.Writer, routes []RouteInfo) error {
+	if routes == nil {
+		routes = []RouteInfo{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(routes)
+}
+
+// funcName returns fn's qualified name, e.g. "main.showUser" or
+// "main.(*UserController).Show" for a method value.
+func funcName(fn any) string {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return fmt.Sprintf("%T", fn)
+	}
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return "Closure"
+	}
+	return strings.TrimSuffix(f.Name(), "-fm")
+}
@@ -0,0 +1,154 @@
+package routing_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func showUser(w http.ResponseWriter, r *http.Request) { w.WriteHeader(200) }
+
+func listRouter() *routing.Router {
+	r := routing.New()
+	r.AliasMiddleware("auth", passthrough)
+	r.Get("/", okHandler).Name("home")
+	r.With("auth").As("api.").Prefix("/api", func(api *routing.Router) {
+		api.Get("/users/{id}", showUser).Name("users.show")
+		api.Resource("/photos", &stubController{}, routing.Only("index"))
+	})
+	return r
+}
+
+func passthrough(next http.Handler) http.Handler { return next }
+
+func findInfo(infos []routing.RouteInfo, uri string) (routing.RouteInfo, bool) {
+	for _, info := range infos {
+		if info.URI == uri {
+			return info, true
+		}
+	}
+	return routing.RouteInfo{}, false
+}
+
+// ── List ──────────────────────────────────────────────────────────────────────
+
+func TestList_RecordsGroupAndResourceRoutes(t *testing.T) {
+	infos := listRouter().List(routing.RouteFilter{})
+	if len(infos) != 3 {
+		t.Fatalf("got %d routes want 3: %+v", len(infos), infos)
+	}
+	if infos[0].URI != "/" || infos[1].URI != "/api/photos" || infos[2].URI != "/api/users/{id}" {
+		t.Errorf("not sorted by URI: %+v", infos)
+	}
+
+	user, _ := findInfo(infos, "/api/users/{id}")
+	if user.Name != "api.users.show" || user.Methods[0] != "GET" {
+		t.Errorf("got %+v", user)
+	}
+	if user.Action != "github.com/km-arc/go-laravel/framework/routing_test.showUser" {
+		t.Errorf("action: got %q", user.Action)
+	}
+	if len(user.Middleware) != 1 || user.Middleware[0] != "auth" {
+		t.Errorf("middleware: got %v want [auth]", user.Middleware)
+	}
+
+	photos, _ := findInfo(infos, "/api/photos")
+	if photos.Action != "routing_test.stubController@Index" || photos.Name != "api.photos.index" {
+		t.Errorf("got %+v", photos)
+	}
+}
+
+func TestList_Segments(t *testing.T) {
+	r := routing.New()
+	r.Get("/langs/{code:[a-z]{2}}/files/*", okHandler)
+
+	info := r.List(routing.RouteFilter{})[0]
+	want := []routing.PathSegment{
+		{Literal: "/langs/"},
+		{Param: "code", Regex: "(?:[a-z]{2})"},
+		{Literal: "/files/"},
+		{Param: "*"},
+	}
+	if len(info.Segments) != len(want) {
+		t.Fatalf("got %+v want %+v", info.Segments, want)
+	}
+	for i := range want {
+		if info.Segments[i] != want[i] {
+			t.Errorf("segment %d: got %+v want %+v", i, info.Segments[i], want[i])
+		}
+	}
+}
+
+func TestList_NamesAnonymousMiddleware(t *testing.T) {
+	r := routing.New()
+	r.Group(func(g *routing.Router) {
+		g.Middleware(passthrough)
+		g.Get("/x", okHandler)
+	})
+	info := r.List(routing.RouteFilter{})[0]
+	if len(info.Middleware) != 1 || !strings.HasSuffix(info.Middleware[0], ".passthrough") {
+		t.Errorf("got %v", info.Middleware)
+	}
+}
+
+func TestList_Filters(t *testing.T) {
+	r := listRouter()
+	r.Post("/api/users", okHandler).Name("api.users.store")
+
+	cases := []struct {
+		filter routing.RouteFilter
+		want   int
+	}{
+		{routing.RouteFilter{Method: "post"}, 1},
+		{routing.RouteFilter{Name: "users"}, 2},
+		{routing.RouteFilter{Path: "api"}, 3},
+		{routing.RouteFilter{Method: "GET", Path: "photos"}, 1},
+		{routing.RouteFilter{Name: "missing"}, 0},
+	}
+	for _, c := range cases {
+		if got := len(r.List(c.filter)); got != c.want {
+			t.Errorf("%+v: got %d routes want %d", c.filter, got, c.want)
+		}
+	}
+}
+
+// ── Output ────────────────────────────────────────────────────────────────────
+
+func TestWriteRouteTable(t *testing.T) {
+	var buf bytes.Buffer
+	if err := routing.WriteRouteTable(&buf, listRouter().List(routing.RouteFilter{})); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{"METHOD", "/api/users/{id}", "api.users.show", "Showing [3] routes"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteRouteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := routing.WriteRouteJSON(&buf, listRouter().List(routing.RouteFilter{Name: "home"})); err != nil {
+		t.Fatal(err)
+	}
+	var got []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if len(got) != 1 || got[0]["uri"] != "/" || got[0]["name"] != "home" {
+		t.Errorf("got %v", got)
+	}
+
+	buf.Reset()
+	_ = routing.WriteRouteJSON(&buf, nil)
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("empty list: got %q want []", buf.String())
+	}
+}
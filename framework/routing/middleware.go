@@ -0,0 +1,336 @@
+package routing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ── Middleware registry ──────────────────────────────────────────────────────
+
+// MiddlewareFactory builds a middleware from the parameters of an alias:
+// "throttle:60,1" calls the "throttle" factory with ("60", "1").
+type MiddlewareFactory func(params ...string) func(http.Handler) http.Handler
+
+// middlewareRegistry maps alias and group names to middleware — Laravel's
+// $middlewareAliases, $middlewareGroups and $middlewarePriority.
+type middlewareRegistry struct {
+	aliases  map[string]MiddlewareFactory
+	groups   map[string][]string
+	priority []string
+}
+
+// DefaultMiddlewarePriority is the order named middleware run in when a route
+// collects them from several places; unlisted middleware keep their position.
+var DefaultMiddlewarePriority = []string{"auth", "throttle"}
+
+func newMiddlewareRegistry() middlewareRegistry {
+	return middlewareRegistry{
+		aliases:  make(map[string]MiddlewareFactory),
+		groups:   map[string][]string{"web": nil, "api": nil},
+		priority: append([]string(nil), DefaultMiddlewarePriority...),
+	}
+}
+
+// middlewareEntry is either a named middleware ("auth", "throttle:60,1",
+// "web") resolved when routes compile, or a raw middleware func.
+type middlewareEntry struct {
+	name string
+	fn   func(http.Handler) http.Handler
+}
+
+// AliasMiddleware registers a middleware under a short name usable in
+// Router.With and Route.Middleware.
+//
+//	// Laravel: $middleware->alias(['auth' => Authenticate::class])
+//	r.AliasMiddleware("auth", auth.Middleware)
+//	r.Get("/dashboard", dashboard).Middleware("auth")
+func (r *Router) AliasMiddleware(name string, mw func(http.Handler) http.Handler) {
+	r.AliasMiddlewareFactory(name, func(...string) func(http.Handler) http.Handler { return mw })
+}
+
+// AliasMiddlewareFactory registers a parameterised middleware alias.
+// Parameters follow a colon and are comma separated.
+//
+//	// Laravel: ->middleware('role:admin,editor')
+//	r.AliasMiddlewareFactory("role", func(roles ...string) func(http.Handler) http.Handler {
+//	    return auth.RequireRole(roles...)
+//	})
+func (r *Router) AliasMiddlewareFactory(name string, factory MiddlewareFactory) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.middleware.aliases[name] = factory
+	r.routes.mux.Store(nil)
+}
+
+// MiddlewareGroup defines (or replaces) a named group of middleware.
+// "web" and "api" exist from the start, empty.
+//
+//	// Laravel: $middleware->group('admin', ['auth', 'role:admin'])
+//	r.MiddlewareGroup("admin", "auth", "role:admin")
+func (r *Router) MiddlewareGroup(name string, middleware ...string) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.middleware.groups[name] = append([]string(nil), middleware...)
+	r.routes.mux.Store(nil)
+}
+
+// AppendMiddlewareToGroup adds middleware to the end of a named group.
+//
+//	// Laravel: $middleware->appendToGroup('web', [...])
+func (r *Router) AppendMiddlewareToGroup(name string, middleware ...string) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	g := r.routes.middleware.groups[name]
+	r.routes.middleware.groups[name] = append(g[:len(g):len(g)], middleware...)
+	r.routes.mux.Store(nil)
+}
+
+// PrependMiddlewareToGroup adds middleware to the start of a named group.
+//
+//	// Laravel: $middleware->prependToGroup('api', [...])
+func (r *Router) PrependMiddlewareToGroup(name string, middleware ...string) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	g := r.routes.middleware.groups[name]
+	r.routes.middleware.groups[name] = append(append([]string(nil), middleware...), g...)
+	r.routes.mux.Store(nil)
+}
+
+// MiddlewarePriority sets the order named middleware run in, replacing
+// DefaultMiddlewarePriority. Middleware not in the list keep their position.
+//
+//	// Laravel: $middleware->priority([...])
+//	r.MiddlewarePriority("session", "auth", "throttle")
+func (r *Router) MiddlewarePriority(names ...string) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.middleware.priority = append([]string(nil), names...)
+	r.routes.mux.Store(nil)
+}
+
+// With returns a router whose routes run the named middleware — aliases,
+// aliases with parameters, or middleware groups.
+//
+//	// Laravel: Route::middleware(['auth', 'throttle:60,1'])->group(fn)
+//	r.With("api").Prefix("/api", func(api *routing.Router) { ... })
+func (r *Router) With(middleware ...string) *Router {
+	g := r.group.child()
+	for _, name := range middleware {
+		g.middleware = append(g.middleware, middlewareEntry{name: name})
+	}
+	return r.derive(g)
+}
+
+// Middleware adds named middleware to this route, after any group middleware.
+//
+//	// Laravel: ->middleware('auth')
+//	r.Get("/dashboard", dashboard).Middleware("auth", "verified")
+func (rt *Route) Middleware(names ...string) *Route {
+	rt.routes.mu.Lock()
+	defer rt.routes.mu.Unlock()
+	rt.middleware = append(rt.middleware, names...)
+	rt.routes.mux.Store(nil)
+	return rt
+}
+
+// WithoutMiddleware excludes named middleware inherited from groups; a name
+// without parameters ("throttle") excludes every use of that alias. With no
+// arguments, all group middleware is skipped — global middleware still runs.
+//
+//	// Laravel: ->withoutMiddleware([VerifyCsrfToken::class])
+//	r.Post("/webhooks/stripe", stripeWebhook).WithoutMiddleware("csrf")
+func (rt *Route) WithoutMiddleware(names ...string) *Route {
+	rt.routes.mu.Lock()
+	defer rt.routes.mu.Unlock()
+	if len(names) == 0 {
+		rt.withoutAll = true
+	}
+	rt.without = append(rt.without, names...)
+	rt.routes.mux.Store(nil)
+	return rt
+}
+
+// ── resolution ───────────────────────────────────────────────────────────────
+
+// middlewareFor returns the route's middleware, outermost first (must hold mu).
+func (c *routeCollection) middlewareFor(rt *Route) []func(http.Handler) http.Handler {
+	entries := c.middlewareEntries(rt)
+	mws := make([]func(http.Handler) http.Handler, len(entries))
+	for i, e := range entries {
+		mws[i] = e.fn
+		if e.name != "" {
+			mws[i] = c.middleware.resolve(e.name)
+		}
+	}
+	return mws
+}
+
+// middlewareEntries returns group middleware then the route's own, with
+// groups expanded, exclusions dropped, duplicates removed and named
+// middleware sorted by priority (must hold mu).
+func (c *routeCollection) middlewareEntries(rt *Route) []middlewareEntry {
+	var entries []middlewareEntry
+	if !rt.withoutAll {
+		entries = rt.group.chain()
+	}
+	for _, name := range rt.middleware {
+		entries = append(entries, middlewareEntry{name: name})
+	}
+	entries = c.middleware.expand(entries, map[string]bool{})
+
+	excluded := map[string]bool{}
+	for _, e := range c.middleware.expand(namedEntries(rt.without), map[string]bool{}) {
+		excluded[e.name] = true
+	}
+
+	seen := map[string]bool{}
+	kept := entries[:0]
+	for _, e := range entries {
+		if e.name != "" {
+			if excluded[e.name] || excluded[aliasName(e.name)] || seen[e.name] {
+				continue
+			}
+			seen[e.name] = true
+		}
+		kept = append(kept, e)
+	}
+	c.middleware.sort(kept)
+	return kept
+}
+
+// expand replaces middleware group names with their members, recursively.
+// A group met again inside itself is dropped; Validate reports the cycle.
+func (m *middlewareRegistry) expand(entries []middlewareEntry, visiting map[string]bool) []middlewareEntry {
+	var out []middlewareEntry
+	for _, e := range entries {
+		members, isGroup := m.groups[e.name]
+		if e.name == "" || !isGroup {
+			out = append(out, e)
+			continue
+		}
+		if visiting[e.name] {
+			continue
+		}
+		visiting[e.name] = true
+		out = append(out, m.expand(namedEntries(members), visiting)...)
+		delete(visiting, e.name)
+	}
+	return out
+}
+
+// sort reorders the named middleware found in the priority list among the
+// slots they already occupy; everything else stays where it is.
+func (m *middlewareRegistry) sort(entries []middlewareEntry) {
+	rank := make(map[string]int, len(m.priority))
+	for i, name := range m.priority {
+		rank[name] = i
+	}
+	var slots []int
+	var ranked []middlewareEntry
+	for i, e := range entries {
+		if _, ok := rank[aliasName(e.name)]; ok && e.name != "" {
+			slots = append(slots, i)
+			ranked = append(ranked, e)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return rank[aliasName(ranked[i].name)] < rank[aliasName(ranked[j].name)]
+	})
+	for i, slot := range slots {
+		entries[slot] = ranked[i]
+	}
+}
+
+// resolve turns "throttle:60,1" into middleware via its alias factory.
+func (m *middlewareRegistry) resolve(spec string) func(http.Handler) http.Handler {
+	name, params := parseMiddleware(spec)
+	factory, ok := m.aliases[name]
+	if !ok {
+		panic("routing: " + unknownMiddleware(name).Error())
+	}
+	return factory(params...)
+}
+
+func unknownMiddleware(name string) error {
+	return fmt.Errorf("middleware %q is not registered — see Router.AliasMiddleware", name)
+}
+
+// checkMiddleware reports rt's middleware names with no alias, which would
+// otherwise panic when the routes compile (must hold mu).
+func (c *routeCollection) checkMiddleware(rt *Route) error {
+	var errs []error
+	for _, e := range c.middlewareEntries(rt) {
+		if e.name == "" {
+			continue
+		}
+		if _, ok := c.middleware.aliases[aliasName(e.name)]; !ok {
+			errs = append(errs, fmt.Errorf("route %s: %w", describe(rt), unknownMiddleware(aliasName(e.name))))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// cycles reports middleware groups that include themselves, directly or
+// through other groups.
+func (m *middlewareRegistry) cycles() error {
+	var errs []error
+	done := map[string]bool{}
+	var path []string
+	var visit func(name string)
+	visit = func(name string) {
+		if i := slices.Index(path, name); i >= 0 {
+			cycle := append(slices.Clone(path[i:]), name)
+			errs = append(errs, fmt.Errorf("middleware group %q includes itself: %s", name, strings.Join(cycle, " → ")))
+			return
+		}
+		members, isGroup := m.groups[name]
+		if done[name] || !isGroup {
+			return
+		}
+		path = append(path, name)
+		for _, member := range members {
+			visit(member)
+		}
+		path = path[:len(path)-1]
+		done[name] = true
+	}
+	names := make([]string, 0, len(m.groups))
+	for name := range m.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		visit(name)
+	}
+	return errors.Join(errs...)
+}
+
+// parseMiddleware splits "throttle:60,1" into "throttle" and ["60", "1"].
+func parseMiddleware(spec string) (string, []string) {
+	name, args, found := strings.Cut(spec, ":")
+	if !found || args == "" {
+		return name, nil
+	}
+	params := strings.Split(args, ",")
+	for i := range params {
+		params[i] = strings.TrimSpace(params[i])
+	}
+	return name, params
+}
+
+func aliasName(spec string) string {
+	name, _ := parseMiddleware(spec)
+	return name
+}
+
+func namedEntries(names []string) []middlewareEntry {
+	entries := make([]middlewareEntry, len(names))
+	for i, name := range names {
+		entries[i] = middlewareEntry{name: name}
+	}
+	return entries
+}
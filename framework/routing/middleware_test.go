@@ -0,0 +1,236 @@
+package routing_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+// tracer returns a middleware factory that appends its name (and params) to
+// the X-Trace header, so tests can assert which middleware ran and in what
+// order.
+func tracer(name string) routing.MiddlewareFactory {
+	return func(params ...string) func(http.Handler) http.Handler {
+		label := name
+		if len(params) > 0 {
+			label += ":" + strings.Join(params, ",")
+		}
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("X-Trace", label)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+}
+
+func tracedRouter() *routing.Router {
+	r := routing.New()
+	for _, name := range []string{"auth", "throttle", "session", "verified"} {
+		r.AliasMiddlewareFactory(name, tracer(name))
+	}
+	return r
+}
+
+func trace(t *testing.T, r *routing.Router, path string) string {
+	t.Helper()
+	return strings.Join(do(t, r, http.MethodGet, path).Header().Values("X-Trace"), " ")
+}
+
+// ── Aliases ───────────────────────────────────────────────────────────────────
+
+func TestRoute_MiddlewareAlias(t *testing.T) {
+	r := tracedRouter()
+	r.Get("/dashboard", okHandler).Middleware("auth")
+	r.Get("/public", okHandler)
+
+	if got := trace(t, r, "/dashboard"); got != "auth" {
+		t.Errorf("dashboard trace = %q", got)
+	}
+	if got := trace(t, r, "/public"); got != "" {
+		t.Errorf("public trace = %q", got)
+	}
+}
+
+func TestRoute_MiddlewareParams(t *testing.T) {
+	r := tracedRouter()
+	r.Get("/api", okHandler).Middleware("throttle:60,1")
+
+	if got := trace(t, r, "/api"); got != "throttle:60,1" {
+		t.Errorf("trace = %q", got)
+	}
+}
+
+func TestRouter_AliasMiddleware_Plain(t *testing.T) {
+	r := routing.New()
+	r.AliasMiddleware("auth", func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+	})
+	r.Get("/dashboard", okHandler).Middleware("auth")
+
+	if rr := do(t, r, http.MethodGet, "/dashboard"); rr.Code != http.StatusUnauthorized {
+		t.Errorf("got %d want 401", rr.Code)
+	}
+}
+
+func TestRouter_UnknownAliasPanics(t *testing.T) {
+	r := routing.New()
+	r.Get("/x", okHandler).Middleware("nope")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for unregistered middleware")
+		}
+	}()
+	r.Handler()
+}
+
+func TestRouter_ValidateReportsUnknownAlias(t *testing.T) {
+	r := tracedRouter()
+	r.MiddlewareGroup("web", "session", "csrf")
+	r.Get("/x", okHandler).Middleware("auht")
+	r.Get("/y", okHandler).Middleware("web")
+	r.Get("/z", okHandler).Middleware("throttle:60,1")
+
+	err := r.Validate()
+	if err == nil {
+		t.Fatal("expected an error for unregistered middleware")
+	}
+	for _, want := range []string{`"auht"`, "GET /x", `"csrf"`, "GET /y"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should mention %s", err, want)
+		}
+	}
+	if strings.Contains(err.Error(), "throttle") {
+		t.Errorf("registered alias reported: %v", err)
+	}
+	if err := r.Cache(io.Discard); err == nil {
+		t.Error("Cache: expected an error for unregistered middleware")
+	}
+}
+
+func TestRouter_SignedAlias(t *testing.T) {
+	r := routing.New()
+	r.SetSigningKey("secret")
+	r.Get("/unsubscribe/{user}", okHandler).Name("unsubscribe").Middleware("signed:relative")
+
+	if rr := do(t, r, http.MethodGet, "/unsubscribe/1"); rr.Code != http.StatusForbidden {
+		t.Errorf("unsigned: got %d want 403", rr.Code)
+	}
+	link := r.SignURL("/unsubscribe/1", time.Time{})
+	if rr := do(t, r, http.MethodGet, link); rr.Code != http.StatusOK {
+		t.Errorf("signed: got %d want 200", rr.Code)
+	}
+}
+
+func TestRouter_SignedAliasNeedsKey(t *testing.T) {
+	r := routing.New()
+	r.SetSigningKey("")
+	r.Get("/unsubscribe/{user}", okHandler).Middleware("signed")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic compiling a signed route without a key")
+		}
+	}()
+	r.Handler()
+}
+
+// ── Groups ────────────────────────────────────────────────────────────────────
+
+func TestRouter_With_Group(t *testing.T) {
+	r := tracedRouter()
+	r.With("auth").Group(func(g *routing.Router) {
+		g.Get("/account", okHandler).Middleware("verified")
+	})
+
+	if got := trace(t, r, "/account"); got != "auth verified" {
+		t.Errorf("trace = %q", got)
+	}
+}
+
+func TestRouter_MiddlewareGroups(t *testing.T) {
+	r := tracedRouter()
+	r.AppendMiddlewareToGroup("web", "session")
+	r.AppendMiddlewareToGroup("api", "throttle:api")
+	r.MiddlewareGroup("admin", "web", "auth")
+
+	r.With("admin").Get("/admin", okHandler)
+	r.With("api").Prefix("/api", func(api *routing.Router) {
+		api.Get("/users", okHandler)
+	})
+
+	if got := trace(t, r, "/admin"); got != "session auth" {
+		t.Errorf("admin trace = %q", got)
+	}
+	if got := trace(t, r, "/api/users"); got != "throttle:api" {
+		t.Errorf("api trace = %q", got)
+	}
+}
+
+func TestRouter_MiddlewareGroupCycle(t *testing.T) {
+	r := tracedRouter()
+	r.MiddlewareGroup("web", "session", "admin")
+	r.MiddlewareGroup("admin", "auth", "web")
+	r.Get("/dashboard", okHandler).Middleware("admin")
+
+	infos := r.List(routing.RouteFilter{}) // must not recurse forever
+	if got := strings.Join(infos[0].Middleware, ","); got != "session,auth" && got != "auth,session" {
+		t.Errorf("middleware: got %q", got)
+	}
+	err := r.Validate()
+	if err == nil || !strings.Contains(err.Error(), "admin → web → admin") {
+		t.Errorf("got %v, want the cycle reported", err)
+	}
+}
+
+func TestRouter_MiddlewareDeduplicated(t *testing.T) {
+	r := tracedRouter()
+	r.With("auth").Get("/x", okHandler).Middleware("auth")
+
+	if got := trace(t, r, "/x"); got != "auth" {
+		t.Errorf("trace = %q", got)
+	}
+}
+
+// ── WithoutMiddleware ─────────────────────────────────────────────────────────
+
+func TestRoute_WithoutMiddleware(t *testing.T) {
+	r := tracedRouter()
+	r.With("auth", "throttle:60,1").Group(func(g *routing.Router) {
+		g.Get("/webhook", okHandler).WithoutMiddleware("throttle")
+		g.Get("/open", okHandler).WithoutMiddleware()
+	})
+
+	if got := trace(t, r, "/webhook"); got != "auth" {
+		t.Errorf("webhook trace = %q", got)
+	}
+	if got := trace(t, r, "/open"); got != "" {
+		t.Errorf("open trace = %q", got)
+	}
+}
+
+// ── Priority ──────────────────────────────────────────────────────────────────
+
+func TestRouter_MiddlewarePriority(t *testing.T) {
+	r := tracedRouter()
+	r.With("throttle:60,1", "verified").Get("/x", okHandler).Middleware("auth")
+
+	// Default priority puts auth before throttle; verified keeps its slot.
+	if got := trace(t, r, "/x"); got != "auth verified throttle:60,1" {
+		t.Errorf("default priority trace = %q", got)
+	}
+
+	r.MiddlewarePriority("throttle", "auth")
+	if got := trace(t, r, "/x"); got != "throttle:60,1 verified auth" {
+		t.Errorf("custom priority trace = %q", got)
+	}
+}
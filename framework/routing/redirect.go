@@ -0,0 +1,113 @@
+package routing
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+)
+
+// ── Redirect & view routes ───────────────────────────────────────────────────
+
+// redirectRoute is the target of a Router.Redirect route.
+type redirectRoute struct {
+	to     string
+	status int
+}
+
+// viewRoute is the template of a Router.View route.
+type viewRoute struct {
+	name string
+	data map[string]any
+}
+
+// Redirect registers a route redirecting every method to another URI, with
+// a 302 unless a status is given. {param} segments in to are filled from
+// the matched route.
+//
+//	// Laravel: Route::redirect('/here', '/there', 301)
+//	r.Redirect("/here", "/there")
+//	r.Redirect("/posts/{id}", "/articles/{id}", http.StatusMovedPermanently)
+func (r *Router) Redirect(from, to string, status ...int) *Route {
+	code := http.StatusFound
+	if len(status) > 0 {
+		code = status[0]
+	}
+	rt := &Route{
+		methods:  []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "HEAD"},
+		uri:      joinPath(r.group.fullPrefix(), from),
+		domain:   r.group.fullDomain(),
+		version:  r.group.fullVersion(),
+		redirect: &redirectRoute{to: to, status: code},
+		action:   "redirect:" + to,
+		group:    r.group,
+		routes:   r.routes,
+	}
+	return r.routes.add(rt)
+}
+
+// PermanentRedirect registers a 301 redirect.
+//
+//	// Laravel: Route::permanentRedirect('/here', '/there')
+func (r *Router) PermanentRedirect(from, to string) *Route {
+	return r.Redirect(from, to, http.StatusMovedPermanently)
+}
+
+// View registers a GET route rendering a template with the ViewEngine bound
+// as "view" in the container (see SetContainer), or the one given to
+// SetViews. Template request helpers such as csrf_field work as usual.
+//
+//	// Laravel: Route::view('/welcome', 'welcome', ['name' => 'Taylor'])
+//	r.View("/welcome", "welcome", map[string]any{"name": "Taylor"})
+func (r *Router) View(uri, name string, data map[string]any) *Route {
+	rt := &Route{
+		methods: []string{"GET", "HEAD"},
+		uri:     joinPath(r.group.fullPrefix(), uri),
+		domain:  r.group.fullDomain(),
+		version: r.group.fullVersion(),
+		view:    &viewRoute{name: name, data: data},
+		action:  "view:" + name,
+		group:   r.group,
+		routes:  r.routes,
+	}
+	return r.routes.add(rt)
+}
+
+// redirectHandler redirects to rt's target with its parameters filled in.
+func redirectHandler(target *redirectRoute) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		to := target.to
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && strings.Contains(to, "{") {
+			for i, key := range rctx.URLParams.Keys {
+				to = strings.ReplaceAll(to, "{"+key+"}", url.PathEscape(rctx.URLParams.Values[i]))
+			}
+		}
+		gohttp.NewResponse(w).WithRequest(r).Redirect(target.status, to)
+	})
+}
+
+// viewHandler renders a View route's template.
+func (c *routeCollection) viewHandler(rt *Route) http.Handler {
+	view := rt.view
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		views := c.viewEngine()
+		if views == nil {
+			panic(fmt.Sprintf("routing: view route %s needs a *gohttp.ViewEngine bound as \"view\"", rt.uri))
+		}
+		views.Request(r).View(w, view.name, view.data)
+	})
+}
+
+// viewEngine returns the engine bound as "view", then the SetViews one.
+func (c *routeCollection) viewEngine() *gohttp.ViewEngine {
+	if app := c.container.Load(); app != nil && app.Bound("view") {
+		if views, ok := app.Make("view").(*gohttp.ViewEngine); ok {
+			return views
+		}
+	}
+	return c.views.Load()
+}
@@ -0,0 +1,135 @@
+package routing_test
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/container"
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func viewDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "welcome.html"), []byte(`Hello, {{.name}}!`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// ── Redirect ─────────────────────────────────────────────────────────────────
+
+func TestRedirect(t *testing.T) {
+	r := routing.New()
+	r.Redirect("/here", "/there")
+	r.PermanentRedirect("/old", "/new")
+	r.Redirect("/posts/{id}", "/articles/{id}", http.StatusTemporaryRedirect)
+
+	cases := []struct {
+		method, path string
+		status       int
+		location     string
+	}{
+		{http.MethodGet, "/here", http.StatusFound, "/there"},
+		{http.MethodPost, "/here", http.StatusFound, "/there"},
+		{http.MethodGet, "/old", http.StatusMovedPermanently, "/new"},
+		{http.MethodGet, "/posts/7", http.StatusTemporaryRedirect, "/articles/7"},
+	}
+	for _, tc := range cases {
+		rr := do(t, r, tc.method, tc.path)
+		if rr.Code != tc.status || rr.Header().Get("Location") != tc.location {
+			t.Errorf("%s %s: got %d %q want %d %q", tc.method, tc.path, rr.Code, rr.Header().Get("Location"), tc.status, tc.location)
+		}
+	}
+}
+
+func TestRedirect_RelativeTarget(t *testing.T) {
+	r := routing.New()
+	r.Prefix("/docs", func(docs *routing.Router) {
+		docs.Redirect("/latest", "v2")
+	})
+	if got := do(t, r, http.MethodGet, "/docs/latest").Header().Get("Location"); got != "/docs/v2" {
+		t.Errorf("got %q want /docs/v2", got)
+	}
+}
+
+func TestRedirectToRoute(t *testing.T) {
+	r := routing.New()
+	r.SetBaseURL("https://example.com")
+	r.Get("/users/{id}", okHandler).Name("users.show")
+	r.Post("/users", func(res *gohttp.Response) error {
+		return res.RedirectToRoute("users.show", map[string]any{"id": 5})
+	})
+	rr := do(t, r, http.MethodPost, "/users")
+	if rr.Code != http.StatusFound || rr.Header().Get("Location") != "https://example.com/users/5" {
+		t.Errorf("got %d %q", rr.Code, rr.Header().Get("Location"))
+	}
+}
+
+// ── View ─────────────────────────────────────────────────────────────────────
+
+func TestView_FromContainer(t *testing.T) {
+	app := container.New()
+	app.Instance("view", gohttp.NewViewEngine(viewDir(t), ".html"))
+	r := routing.New()
+	r.SetContainer(app)
+	r.View("/welcome", "welcome", map[string]any{"name": "Taylor"})
+
+	rr := do(t, r, http.MethodGet, "/welcome")
+	if rr.Code != http.StatusOK || rr.Body.String() != "Hello, Taylor!" {
+		t.Errorf("got %d %q", rr.Code, rr.Body.String())
+	}
+	if rr := do(t, r, http.MethodPost, "/welcome"); rr.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST: got %d want 405", rr.Code)
+	}
+}
+
+func TestView_FromSetViews(t *testing.T) {
+	r := routing.New()
+	r.SetViews(gohttp.NewViewEngine(viewDir(t), ".html"))
+	r.View("/welcome", "welcome", map[string]any{"name": "Abigail"})
+	if got := do(t, r, http.MethodGet, "/welcome").Body.String(); got != "Hello, Abigail!" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestView_WithoutEngineIs500(t *testing.T) {
+	r := routing.New()
+	r.View("/welcome", "welcome", nil)
+	if rr := do(t, r, http.MethodGet, "/welcome"); rr.Code != http.StatusInternalServerError {
+		t.Errorf("got %d want 500", rr.Code)
+	}
+}
+
+// ── Cache ────────────────────────────────────────────────────────────────────
+
+func TestRedirectAndView_Cached(t *testing.T) {
+	src := routing.New()
+	src.PermanentRedirect("/old", "/new")
+	src.View("/welcome", "welcome", map[string]any{"name": "Taylor"}).Name("welcome")
+	var buf bytes.Buffer
+	if err := src.Cache(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	r := routing.New()
+	r.SetViews(gohttp.NewViewEngine(viewDir(t), ".html"))
+	if err := r.LoadCache(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if rr := do(t, r, http.MethodGet, "/old"); rr.Code != http.StatusMovedPermanently || rr.Header().Get("Location") != "/new" {
+		t.Errorf("redirect: got %d %q", rr.Code, rr.Header().Get("Location"))
+	}
+	if got := do(t, r, http.MethodGet, "/welcome").Body.String(); got != "Hello, Taylor!" {
+		t.Errorf("view: got %q", got)
+	}
+	if infos := r.List(routing.RouteFilter{Name: "welcome"}); len(infos) != 1 || infos[0].Action != "view:welcome" {
+		t.Errorf("list: %+v", infos)
+	}
+}
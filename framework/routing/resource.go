@@ -0,0 +1,246 @@
+package routing
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ── Resource routes ──────────────────────────────────────────────────────────
+
+// NestedResource is implemented by controllers of a nested resource; it
+// names the parent resources, e.g. "photos" or "users.photos".
+//
+//	func (*CommentController) ParentResource() string { return "photos" }
+//	r.Resource("/comments", &CommentController{}) // /photos/{photo}/comments/{id}
+type NestedResource interface {
+	ParentResource() string
+}
+
+// ShallowResource is implemented by nested controllers whose member routes
+// (show, edit, update, destroy) should not repeat the parent, like Shallow.
+type ShallowResource interface {
+	ShallowResource() bool
+}
+
+// ResourceOption customises Resource and ApiResource.
+type ResourceOption func(*resourceOptions)
+
+type resourceOptions struct {
+	only, except []string
+	names        map[string]string
+	params       map[string]string
+	shallow      bool
+}
+
+// Only registers just the given actions.
+//
+//	// Laravel: ->only(['index', 'show'])
+//	r.Resource("/photos", c, routing.Only("index", "show"))
+func Only(actions ...string) ResourceOption {
+	return func(o *resourceOptions) { o.only = actions }
+}
+
+// Except registers every action but the given ones.
+//
+//	// Laravel: ->except(['destroy'])
+func Except(actions ...string) ResourceOption {
+	return func(o *resourceOptions) { o.except = append(o.except, actions...) }
+}
+
+// Names overrides route names per action.
+//
+//	// Laravel: ->names(['create' => 'photos.build'])
+//	r.Resource("/photos", c, routing.Names(map[string]string{"create": "photos.build"}))
+func Names(names map[string]string) ResourceOption {
+	return func(o *resourceOptions) { o.names = names }
+}
+
+// Parameters overrides route parameter names per resource. The resource's
+// own parameter defaults to "id"; parents default to their singular name.
+//
+//	// Laravel: ->parameters(['photos' => 'photo'])
+//	r.Resource("/photos", c, routing.Parameters(map[string]string{"photos": "photo"}))
+//	// GET /photos/{photo}
+func Parameters(params map[string]string) ResourceOption {
+	return func(o *resourceOptions) { o.params = params }
+}
+
+// Shallow registers the member routes of a nested resource without the
+// parent: /photos/{photo}/comments for index, create and store, but
+// /comments/{id} for show, edit, update and destroy.
+//
+//	// Laravel: Route::resource('photos.comments', C::class)->shallow()
+func Shallow() ResourceOption {
+	return func(o *resourceOptions) { o.shallow = true }
+}
+
+// resourceAction is one of the seven resource routes.
+type resourceAction struct {
+	name    string
+	methods []string
+	member  bool   // acts on one item: /photos/{id}
+	suffix  string // after the base or member URI
+	method  string // controller method, e.g. "Index"
+}
+
+// resourceActions are listed in Laravel's registration order.
+var resourceActions = []resourceAction{
+	{"index", []string{"GET"}, false, "", "Index"},
+	{"create", []string{"GET"}, false, "/create", "Create"},
+	{"store", []string{"POST"}, false, "", "Store"},
+	{"show", []string{"GET"}, true, "", "Show"},
+	{"edit", []string{"GET"}, true, "/edit", "Edit"},
+	{"update", []string{"PUT", "PATCH"}, true, "", "Update"},
+	{"destroy", []string{"DELETE"}, true, "", "Destroy"},
+}
+
+// hasMethod reports whether c has an exported method called name.
+func hasMethod(c any, name string) bool {
+	return reflect.ValueOf(c).MethodByName(name).IsValid()
+}
+
+// Resource registers RESTful routes for every action the controller
+// implements. Routes are named after the pattern: "/photos" registers
+// photos.index, photos.show, ... Actions may use any handler signature
+// Match accepts.
+//
+//	GET       /photos              → Index    photos.index
+//	GET       /photos/create       → Create   photos.create
+//	POST      /photos              → Store    photos.store
+//	GET       /photos/{id}         → Show     photos.show
+//	GET       /photos/{id}/edit    → Edit     photos.edit
+//	PUT/PATCH /photos/{id}         → Update   photos.update
+//	DELETE    /photos/{id}         → Destroy  photos.destroy
+//
+// Nest resources with Laravel's dot syntax or the NestedResource interface:
+//
+//	// Laravel: Route::resource('photos.comments', CommentController::class)
+//	r.Resource("/photos.comments", &CommentController{})
+//	// GET /photos/{photo}/comments/{id} → photos.comments.show
+//
+// Listing an action in Only that the controller lacks panics, as do
+// options that exclude every action and a controller with none of them.
+func (r *Router) Resource(pattern string, c any, opts ...ResourceOption) {
+	r.resource(pattern, c, false, opts)
+}
+
+// ApiResource is Resource without the HTML form actions (create, edit).
+//
+//	// Laravel: Route::apiResource('photos', PhotoController::class)
+func (r *Router) ApiResource(pattern string, c any, opts ...ResourceOption) {
+	r.resource(pattern, c, true, opts)
+}
+
+func (r *Router) resource(pattern string, c any, api bool, opts []ResourceOption) {
+	o := resourceOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if api {
+		o.except = append(o.except, "create", "edit")
+	}
+	if s, ok := c.(ShallowResource); ok && s.ShallowResource() {
+		o.shallow = true
+	}
+
+	prefix, resources := splitResource(pattern)
+	if n, ok := c.(NestedResource); ok && len(resources) == 1 {
+		resources = append(strings.Split(n.ParentResource(), "."), resources...)
+	}
+	leaf := resources[len(resources)-1]
+	parents := resources[:len(resources)-1]
+
+	// /photos/{photo}/comments
+	base := prefix
+	for _, p := range parents {
+		base = joinPath(base, p+"/{"+o.param(p, singular(p))+"}")
+	}
+	base = joinPath(base, leaf)
+	member := base + "/{" + o.param(leaf, "id") + "}"
+
+	name := strings.Join(append(nameParts(prefix), resources...), ".")
+	shallowName := strings.Join(append(nameParts(prefix), leaf), ".")
+	if o.shallow && len(parents) > 0 {
+		member = joinPath(prefix, leaf) + "/{" + o.param(leaf, "id") + "}"
+	}
+
+	if !slices.ContainsFunc(resourceActions, func(a resourceAction) bool { return o.includes(a.name) }) {
+		panic(fmt.Sprintf("routing: Only/Except leave no actions for resource %q", pattern))
+	}
+	registered := 0
+	for _, a := range resourceActions {
+		if !o.includes(a.name) {
+			continue
+		}
+		if !hasMethod(c, a.method) {
+			if slices.Contains(o.only, a.name) {
+				panic(fmt.Sprintf("routing: %T has no %s action for resource %q", c, a.name, pattern))
+			}
+			continue
+		}
+
+		uri, routeName := base+a.suffix, name+"."+a.name
+		if a.member {
+			uri = member + a.suffix
+			if o.shallow && len(parents) > 0 {
+				routeName = shallowName + "." + a.name
+			}
+		}
+		if custom, ok := o.names[a.name]; ok {
+			routeName = custom
+		}
+		r.Match(a.methods, uri, Action(c, a.method)).Name(routeName)
+		registered++
+	}
+	if registered == 0 {
+		panic(fmt.Sprintf("routing: %T has no actions for resource %q", c, pattern))
+	}
+}
+
+func (o *resourceOptions) includes(action string) bool {
+	if len(o.only) > 0 && !slices.Contains(o.only, action) {
+		return false
+	}
+	return !slices.Contains(o.except, action)
+}
+
+func (o *resourceOptions) param(resource, fallback string) string {
+	if p, ok := o.params[resource]; ok {
+		return p
+	}
+	return fallback
+}
+
+// splitResource turns "/admin/photos.comments" into "/admin" and
+// ["photos", "comments"].
+func splitResource(pattern string) (string, []string) {
+	pattern = "/" + strings.Trim(pattern, "/")
+	prefix, last := path.Split(pattern)
+	return strings.TrimRight(prefix, "/"), strings.Split(last, ".")
+}
+
+// nameParts turns "/admin" into ["admin"], for route names like
+// admin.photos.index.
+func nameParts(prefix string) []string {
+	if prefix = strings.Trim(prefix, "/"); prefix == "" {
+		return nil
+	}
+	return strings.Split(prefix, "/")
+}
+
+// singular is a small English singulariser for parameter names:
+// photos → photo, categories → category, boxes → box.
+func singular(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 3:
+		return word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "xes"), strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "shes"), strings.HasSuffix(word, "sses"):
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return word[:len(word)-1]
+	}
+	return word
+}
@@ -0,0 +1,188 @@
+package routing_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+// formController adds the HTML form actions to stubController.
+type formController struct{ stubController }
+
+func (formController) Create(w http.ResponseWriter, r *http.Request) { w.WriteHeader(200) }
+func (formController) Edit(w http.ResponseWriter, r *http.Request)   { w.WriteHeader(200) }
+
+// readOnlyController implements only Index and Show.
+type readOnlyController struct{}
+
+func (readOnlyController) Index(w http.ResponseWriter, r *http.Request) { w.WriteHeader(200) }
+func (readOnlyController) Show(w http.ResponseWriter, r *http.Request)  { w.WriteHeader(200) }
+
+// commentController is nested under photos via NestedResource.
+type commentController struct {
+	stubController
+	shallow bool
+}
+
+func (commentController) ParentResource() string  { return "photos" }
+func (c commentController) ShallowResource() bool { return c.shallow }
+
+func routeURIs(r *routing.Router, names ...string) map[string]string {
+	uris := map[string]string{}
+	for _, n := range names {
+		if rt := r.Route(n); rt != nil {
+			uris[n] = rt.URI()
+		}
+	}
+	return uris
+}
+
+// ── Actions ───────────────────────────────────────────────────────────────────
+
+func TestResource_CreateAndEdit(t *testing.T) {
+	r := routing.New()
+	r.Resource("/photos", &formController{})
+
+	for _, path := range []string{"/photos/create", "/photos/1/edit"} {
+		if rr := do(t, r, http.MethodGet, path); rr.Code != http.StatusOK {
+			t.Errorf("GET %s: got %d want 200", path, rr.Code)
+		}
+	}
+}
+
+func TestResource_PartialController(t *testing.T) {
+	r := routing.New()
+	r.Resource("/tags", readOnlyController{})
+
+	if !r.HasRoute("tags.index") || !r.HasRoute("tags.show") {
+		t.Error("expected index and show routes")
+	}
+	if r.HasRoute("tags.store") || r.HasRoute("tags.destroy") {
+		t.Error("unexpected routes for missing actions")
+	}
+}
+
+func TestApiResource_SkipsFormActions(t *testing.T) {
+	r := routing.New()
+	r.ApiResource("/photos", &formController{})
+
+	if r.HasRoute("photos.create") || r.HasRoute("photos.edit") {
+		t.Error("ApiResource registered create/edit")
+	}
+	if !r.HasRoute("photos.store") {
+		t.Error("ApiResource missing store")
+	}
+}
+
+// ── Options ───────────────────────────────────────────────────────────────────
+
+func TestResource_OnlyExcept(t *testing.T) {
+	r := routing.New()
+	r.Resource("/photos", &stubController{}, routing.Only("index", "show"))
+	r.Resource("/posts", &stubController{}, routing.Except("destroy"))
+
+	if r.HasRoute("photos.store") || !r.HasRoute("photos.show") {
+		t.Error("Only not applied")
+	}
+	if r.HasRoute("posts.destroy") || !r.HasRoute("posts.update") {
+		t.Error("Except not applied")
+	}
+}
+
+func TestResource_OnlyMissingActionPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	routing.New().Resource("/tags", readOnlyController{}, routing.Only("store"))
+}
+
+func TestResource_NoActionsPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for a controller without actions")
+		}
+	}()
+	routing.New().Resource("/tags", struct{}{})
+}
+
+func TestResource_OptionsExcludingEverythingPanic(t *testing.T) {
+	defer func() {
+		p := recover()
+		if msg, _ := p.(string); !strings.Contains(msg, "Only/Except leave no actions") {
+			t.Errorf("got panic %v", p)
+		}
+	}()
+	routing.New().ApiResource("/tags", readOnlyController{}, routing.Only("create"))
+}
+
+func TestResource_NamesAndParameters(t *testing.T) {
+	r := routing.New()
+	r.Resource("/photos", &stubController{},
+		routing.Names(map[string]string{"show": "gallery.photo"}),
+		routing.Parameters(map[string]string{"photos": "photo"}))
+
+	got := routeURIs(r, "gallery.photo", "photos.update")
+	if got["gallery.photo"] != "/photos/{photo}" || got["photos.update"] != "/photos/{photo}" {
+		t.Errorf("got %v", got)
+	}
+}
+
+// ── Nesting ───────────────────────────────────────────────────────────────────
+
+func TestResource_NestedDotSyntax(t *testing.T) {
+	r := routing.New()
+	r.Resource("/photos.comments", &stubController{})
+
+	got := routeURIs(r, "photos.comments.index", "photos.comments.show")
+	want := map[string]string{
+		"photos.comments.index": "/photos/{photo}/comments",
+		"photos.comments.show":  "/photos/{photo}/comments/{id}",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s: got %q want %q", k, got[k], v)
+		}
+	}
+	if rr := do(t, r, http.MethodDelete, "/photos/1/comments/2"); rr.Code != http.StatusNoContent {
+		t.Errorf("DELETE nested: got %d", rr.Code)
+	}
+}
+
+func TestResource_NestedInterface(t *testing.T) {
+	r := routing.New()
+	r.Resource("/comments", &commentController{})
+
+	if got := routeURIs(r, "photos.comments.store")["photos.comments.store"]; got != "/photos/{photo}/comments" {
+		t.Errorf("store: got %q", got)
+	}
+}
+
+func TestResource_Shallow(t *testing.T) {
+	for name, register := range map[string]func(*routing.Router){
+		"option":    func(r *routing.Router) { r.Resource("/photos.comments", &stubController{}, routing.Shallow()) },
+		"interface": func(r *routing.Router) { r.Resource("/comments", &commentController{shallow: true}) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := routing.New()
+			register(r)
+
+			got := routeURIs(r, "photos.comments.index", "comments.show", "comments.destroy")
+			want := map[string]string{
+				"photos.comments.index": "/photos/{photo}/comments",
+				"comments.show":         "/comments/{id}",
+				"comments.destroy":      "/comments/{id}",
+			}
+			for k, v := range want {
+				if got[k] != v {
+					t.Errorf("%s: got %q want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
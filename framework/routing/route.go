@@ -0,0 +1,282 @@
+package routing
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/km-arc/go-laravel/framework/container"
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+)
+
+// ── Route ────────────────────────────────────────────────────────────────────
+
+// Route is a single registered route. The HTTP verb helpers return it so
+// attributes can be chained — Laravel: Route::get(...)->name('users.show').
+//
+//	r.Get("/users/{id}", showUser).Name("users.show")
+type Route struct {
+	methods []string
+	uri     string // full chi pattern, including group prefixes
+	domain  string // host pattern from Router.Domain, e.g. "{account}.example.com"
+	name    string
+	handler http.Handler
+	value   any    // handler as given to Match, matched against registered handlers
+	action  string // handler name for Router.List, e.g. "main.PhotoController@Index"
+	group   *group
+
+	handlerKey string        // registered handler key, resolved on compile (see Router.RegisterHandler)
+	fallback   bool          // registered with Router.Fallback
+	version    *APIVersion   // set by Router.Version
+	timeout    time.Duration // see Route.Timeout
+	maxBody    int64         // see Route.MaxBody
+	redirect   *redirectRoute
+	view       *viewRoute
+	routes     *routeCollection
+
+	doc        RouteDoc          // API documentation, see Route.Summary
+	result     reflect.Type      // value type returned by a typed handler
+	wheres     map[string]string // parameter constraints added with Route.Where
+	middleware []string          // named middleware added with Route.Middleware
+	without    []string          // named middleware excluded with Route.WithoutMiddleware
+	withoutAll bool              // WithoutMiddleware() with no names: skip group middleware
+}
+
+// Name sets the route name, prefixed by any group name prefix (see Router.As).
+//
+//	// Laravel: ->name('users.show')
+func (rt *Route) Name(name string) *Route {
+	rt.routes.mu.Lock()
+	defer rt.routes.mu.Unlock()
+	if rt.name != "" && rt.routes.byName[rt.name] == rt {
+		delete(rt.routes.byName, rt.name)
+	}
+	rt.name = rt.group.fullNamePrefix() + name
+	rt.routes.byName[rt.name] = rt
+	return rt
+}
+
+// GetName returns the full route name ("" if unnamed).
+func (rt *Route) GetName() string { return rt.name }
+
+// URI returns the full route pattern, e.g. "/api/v1/users/{id}".
+func (rt *Route) URI() string { return rt.uri }
+
+// Methods returns the HTTP methods the route answers to.
+func (rt *Route) Methods() []string { return append([]string(nil), rt.methods...) }
+
+// ── group ────────────────────────────────────────────────────────────────────
+
+// group holds the attributes a Router applies to the routes registered
+// through it — Laravel's route group attribute stack.
+type group struct {
+	parent     *group
+	prefix     string
+	namePrefix string
+	domain     string
+	version    *APIVersion
+	timeout    time.Duration
+	maxBody    int64
+	middleware []middlewareEntry
+}
+
+func (g *group) child() *group { return &group{parent: g} }
+
+// fullPrefix returns the URL prefix accumulated from the root.
+func (g *group) fullPrefix() string {
+	if g == nil {
+		return ""
+	}
+	return joinPath(g.parent.fullPrefix(), g.prefix)
+}
+
+// fullNamePrefix returns the name prefix accumulated from the root.
+func (g *group) fullNamePrefix() string {
+	if g == nil {
+		return ""
+	}
+	return g.parent.fullNamePrefix() + g.namePrefix
+}
+
+// chain returns the group middleware, outermost group first.
+// The root group's middleware is excluded — it is installed on the mux itself.
+func (g *group) chain() []middlewareEntry {
+	if g == nil || g.parent == nil {
+		return nil
+	}
+	return append(g.parent.chain(), g.middleware...)
+}
+
+// ── routeCollection ──────────────────────────────────────────────────────────
+
+// routeCollection is the registry shared by a Router and all of its groups.
+// Routes are compiled into a chi mux lazily, on the first request after a
+// change, so attributes set after registration (names, constraints, ...)
+// are honoured.
+type routeCollection struct {
+	mu     sync.Mutex
+	root   *group
+	routes []*Route
+	byName map[string]*Route
+	mux    atomic.Pointer[http.Handler] // compiled; nil when stale
+
+	container  atomic.Pointer[container.Container] // resolves typed handler parameters
+	views      atomic.Pointer[gohttp.ViewEngine]   // renders HTML 404/405 pages (Router.SetViews)
+	binders    map[string]Binder                   // route parameter → model resolver
+	handlers   map[string]registeredHandler        // Router.RegisterHandler keys
+	patterns   map[string]string                   // global parameter constraints (Router.Pattern)
+	manifests  []*manifest                         // Static asset manifests, for Router.Asset
+	middleware middlewareRegistry
+
+	versionSets   []*versionSet // API versions by prefix (Router.Version)
+	versionVendor string        // vendor in Accept media types, see Router.VersionNegotiation
+	versionHeader string        // header naming a version
+
+	baseURL    string                 // root for absolute URLs, usually APP_URL
+	signingKey atomic.Pointer[[]byte] // HMAC key for signed URLs, usually APP_KEY
+	cached     bool                   // routes were loaded with Router.LoadCache
+}
+
+func newRouteCollection() *routeCollection {
+	return &routeCollection{
+		root:       &group{},
+		byName:     make(map[string]*Route),
+		binders:    make(map[string]Binder),
+		handlers:   make(map[string]registeredHandler),
+		patterns:   make(map[string]string),
+		middleware: newMiddlewareRegistry(),
+
+		versionVendor: "app",
+		versionHeader: "X-API-Version",
+	}
+}
+
+func (c *routeCollection) add(rt *Route) *Route {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.routes = append(c.routes, rt)
+	c.mux.Store(nil)
+	return rt
+}
+
+// handler returns the compiled mux, rebuilding it if routes changed.
+func (c *routeCollection) handler() http.Handler {
+	if h := c.mux.Load(); h != nil {
+		return *h
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h := c.mux.Load()
+	if h == nil {
+		compiled := c.compile()
+		h = &compiled
+		c.mux.Store(h)
+	}
+	return *h
+}
+
+// compile builds a fresh chi mux from the registered routes (must hold mu).
+// Routes with a domain get a mux per domain pattern behind a hostRouter.
+// Fallback routes become the muxes' not-found handlers.
+func (c *routeCollection) compile() http.Handler {
+	fallback := c.newMux()
+	var fallbacks []fallbackRoute
+	var hosts []*hostRoutes
+	byDomain := map[string]*hostRoutes{}
+
+	for _, cr := range c.compiledRoutes() {
+		rt := cr.route
+		mux, hr := fallback, (*hostRoutes)(nil)
+		if rt.domain != "" {
+			domain := c.constrainedDomain(rt)
+			var ok bool
+			hr, ok = byDomain[domain]
+			if !ok {
+				hr = &hostRoutes{mux: c.newMux()}
+				hr.host, hr.params = compileHost(domain)
+				byDomain[domain] = hr
+				hosts = append(hosts, hr)
+			}
+			mux = hr.mux
+		}
+
+		if rt.fallback {
+			f := fallbackRoute{prefix: fallbackPrefix(rt), handler: cr.handler}
+			if hr != nil {
+				hr.fallbacks = append(hr.fallbacks, f)
+			} else {
+				fallbacks = append(fallbacks, f)
+			}
+			continue
+		}
+		for _, m := range cr.methods {
+			mux.Method(m, cr.pattern, cr.handler)
+		}
+	}
+	c.installErrorHandlers(fallback, fallbacks)
+	for _, hr := range hosts {
+		c.installErrorHandlers(hr.mux, hr.fallbacks)
+	}
+	var h http.Handler = fallback
+	if len(hosts) > 0 {
+		h = &hostRouter{hosts: hosts, fallback: fallback}
+	}
+	// Lets Response.RedirectToRoute generate URLs.
+	urls := &Router{routes: c, group: c.root}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, gohttp.WithURLGenerator(r, urls))
+	})
+}
+
+// compiledRoute is a handler wrapped in its middleware, ready to mount.
+// Besides the registered routes, API versions add some (see versionRoutes).
+type compiledRoute struct {
+	route   *Route // the route the handler comes from
+	methods []string
+	pattern string // constrained chi pattern
+	handler http.Handler
+}
+
+// compiledRoutes wraps every route's handler in its middleware (must hold mu).
+func (c *routeCollection) compiledRoutes() []compiledRoute {
+	routes := make([]compiledRoute, 0, len(c.routes))
+	for _, rt := range c.routes {
+		// Bindings run innermost, after group middleware such as auth.
+		h := bindModels(c.routeHandler(rt), rt.domain+rt.uri, c.binders)
+		chain := c.middlewareFor(rt)
+		for i := len(chain) - 1; i >= 0; i-- {
+			h = chain[i](h)
+		}
+		h = c.withLimits(rt, h)
+		routes = append(routes, compiledRoute{route: rt, methods: rt.methods, pattern: c.constrained(rt), handler: h})
+	}
+	return c.versionRoutes(routes)
+}
+
+// newMux returns a chi mux running the root middleware.
+func (c *routeCollection) newMux() *chi.Mux {
+	mux := chi.NewRouter()
+	for _, e := range c.root.middleware {
+		mux.Use(e.fn)
+	}
+	return mux
+}
+
+// joinPath concatenates a group prefix and a route pattern the way Laravel
+// does: prefix("/api") + "/" is "/api", not "/api/".
+func joinPath(prefix, pattern string) string {
+	prefix = strings.TrimRight(prefix, "/")
+	pattern = strings.TrimLeft(pattern, "/")
+	if pattern == "" {
+		if prefix == "" {
+			return "/"
+		}
+		return prefix
+	}
+	return prefix + "/" + pattern
+}
@@ -8,92 +8,126 @@ import (
 )
 
 // Router wraps chi.Router with Laravel-style helpers.
+//
+// Routes are recorded in a registry shared by the router and all of its
+// groups, then compiled into a chi mux on the first request.
 type Router struct {
-	mux chi.Router
+	routes *routeCollection
+	group  *group
 }
 
-// New creates a Router with sane defaults (Logger, Recoverer).
+// New creates a Router with sane defaults (Logger, Recoverer) and the
+// built-in middleware alias "signed" ("signed:relative" for path-only
+// signatures).
 func New() *Router {
-	r := chi.NewRouter()
-	r.Use(middleware.Logger)
-	r.Use(middleware.Recoverer)
-	r.Use(middleware.RealIP)
-	return &Router{mux: r}
+	routes := newRouteCollection()
+	r := &Router{routes: routes, group: routes.root}
+	r.Middleware(middleware.Logger, middleware.Recoverer, middleware.RealIP)
+	r.AliasMiddlewareFactory("signed", func(params ...string) func(http.Handler) http.Handler {
+		return r.validateSignature(len(params) == 0 || params[0] != "relative")
+	})
+	return r
+}
+
+// derive returns a Router sharing the registry but applying group g.
+func (r *Router) derive(g *group) *Router {
+	return &Router{routes: r.routes, group: g}
 }
 
 // ── HTTP verbs ───────────────────────────────────────────────────────────────
 
-func (r *Router) Get(pattern string, h http.HandlerFunc)    { r.mux.Get(pattern, h) }
-func (r *Router) Post(pattern string, h http.HandlerFunc)   { r.mux.Post(pattern, h) }
-func (r *Router) Put(pattern string, h http.HandlerFunc)    { r.mux.Put(pattern, h) }
-func (r *Router) Patch(pattern string, h http.HandlerFunc)  { r.mux.Patch(pattern, h) }
-func (r *Router) Delete(pattern string, h http.HandlerFunc) { r.mux.Delete(pattern, h) }
+func (r *Router) Get(pattern string, h any) *Route {
+	return r.Match([]string{"GET"}, pattern, h)
+}
+func (r *Router) Post(pattern string, h any) *Route {
+	return r.Match([]string{"POST"}, pattern, h)
+}
+func (r *Router) Put(pattern string, h any) *Route {
+	return r.Match([]string{"PUT"}, pattern, h)
+}
+func (r *Router) Patch(pattern string, h any) *Route {
+	return r.Match([]string{"PATCH"}, pattern, h)
+}
+func (r *Router) Delete(pattern string, h any) *Route {
+	return r.Match([]string{"DELETE"}, pattern, h)
+}
 
 // Any registers a handler for all common HTTP methods.
-func (r *Router) Any(pattern string, h http.HandlerFunc) {
-	for _, m := range []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "HEAD"} {
-		r.mux.Method(m, pattern, h)
+func (r *Router) Any(pattern string, h any) *Route {
+	return r.Match([]string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "HEAD"}, pattern, h)
+}
+
+// Match registers a handler for the given HTTP methods.
+//
+//	// Laravel: Route::match(['get', 'post'], '/', fn)
+//	r.Match([]string{"GET", "POST"}, "/", handler)
+//
+// Besides an http.HandlerFunc or http.Handler, the handler may be a
+// controller method reference (see Action) or any function taking
+// http.ResponseWriter, *http.Request, context.Context, *gohttp.Request,
+// *gohttp.Response or types bound in the container (see SetContainer), and
+// returning nothing, an error, a value, or a value and an error. Values are
+// sent as JSON; errors become responses — Abort(403) → 403,
+// ErrModelNotFound → 404, *validation.Errors → 422, anything else → 500.
+//
+//	// Laravel: public function show(Request $request, UserRepository $users)
+//	r.Get("/users/{id}", func(req *gohttp.Request, users *UserRepository) (*User, error) {
+//	    return users.Find(req.RouteParam("id"))
+//	})
+//
+// A string is the key of a handler added with RegisterHandler.
+func (r *Router) Match(methods []string, pattern string, h any) *Route {
+	rt := &Route{
+		methods: methods,
+		uri:     joinPath(r.group.fullPrefix(), pattern),
+		domain:  r.group.fullDomain(),
+		version: r.group.fullVersion(),
+		group:   r.group,
+		routes:  r.routes,
 	}
+	r.routes.setHandler(rt, h)
+	return r.routes.add(rt)
 }
 
 // ── Groups & Prefixes ────────────────────────────────────────────────────────
 
 // Group creates an inline group — Laravel: Route::group([], fn)
 func (r *Router) Group(fn func(r *Router)) {
-	r.mux.Group(func(mx chi.Router) {
-		fn(&Router{mux: mx})
-	})
+	fn(r.derive(r.group.child()))
 }
 
 // Prefix creates a sub-router with a URL prefix — Laravel: Route::prefix('/api')
 func (r *Router) Prefix(pattern string, fn func(r *Router)) {
-	r.mux.Route(pattern, func(mx chi.Router) {
-		fn(&Router{mux: mx})
-	})
+	g := r.group.child()
+	g.prefix = pattern
+	fn(r.derive(g))
+}
+
+// As returns a router whose route names are prefixed with prefix.
+// The prefix is inherited by nested Group / Prefix calls.
+//
+//	// Laravel: Route::name('admin.')->prefix('admin')->group(fn)
+//	r.As("admin.").Prefix("/admin", func(admin *routing.Router) {
+//	    admin.Get("/users", listUsers).Name("users.index") // "admin.users.index"
+//	})
+func (r *Router) As(prefix string) *Router {
+	g := r.group.child()
+	g.namePrefix = prefix
+	return r.derive(g)
 }
 
 // ── Middleware ───────────────────────────────────────────────────────────────
 
 // Middleware adds one or more middleware to the router.
+// On the root router it runs for every request; on a group it wraps the
+// group's routes. For named middleware ("auth", "throttle:60,1") see With.
 func (r *Router) Middleware(mw ...func(http.Handler) http.Handler) {
-	r.mux.Use(mw...)
-}
-
-// ── Named / Resource routes ──────────────────────────────────────────────────
-
-// Resource registers standard RESTful routes for a resource controller.
-//
-//	GET    /photos           → c.Index
-//	POST   /photos           → c.Store
-//	GET    /photos/{id}      → c.Show
-//	PUT    /photos/{id}      → c.Update
-//	DELETE /photos/{id}      → c.Destroy
-type ResourceController interface {
-	Index(w http.ResponseWriter, r *http.Request)
-	Store(w http.ResponseWriter, r *http.Request)
-	Show(w http.ResponseWriter, r *http.Request)
-	Update(w http.ResponseWriter, r *http.Request)
-	Destroy(w http.ResponseWriter, r *http.Request)
-}
-
-func (r *Router) Resource(pattern string, c ResourceController) {
-	r.mux.Get(pattern, c.Index)
-	r.mux.Post(pattern, c.Store)
-	r.mux.Get(pattern+"/{id}", c.Show)
-	r.mux.Put(pattern+"/{id}", c.Update)
-	r.mux.Patch(pattern+"/{id}", c.Update)
-	r.mux.Delete(pattern+"/{id}", c.Destroy)
-}
-
-// ── Static files ─────────────────────────────────────────────────────────────
-
-// Static serves a filesystem at the given prefix.
-// e.g. router.Static("/public", "./public")
-func (r *Router) Static(prefix, dir string) {
-	fs := http.StripPrefix(prefix, http.FileServer(http.Dir(dir)))
-	r.mux.Get(prefix+"/*", func(w http.ResponseWriter, req *http.Request) {
-		fs.ServeHTTP(w, req)
-	})
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	for _, fn := range mw {
+		r.group.middleware = append(r.group.middleware, middlewareEntry{fn: fn})
+	}
+	r.routes.mux.Store(nil)
 }
 
 // ── Params ───────────────────────────────────────────────────────────────────
@@ -107,10 +141,10 @@ func Param(r *http.Request, key string) string {
 
 // ServeHTTP implements http.Handler so Router can be passed to http.ListenAndServe.
 func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	r.mux.ServeHTTP(w, req)
+	r.routes.handler().ServeHTTP(w, req)
 }
 
 // Handler returns the underlying http.Handler (for testing etc.).
 func (r *Router) Handler() http.Handler {
-	return r.mux
+	return r.routes.handler()
 }
@@ -3,6 +3,7 @@ package routing_test
 import (
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/km-arc/go-laravel/routing"
@@ -201,3 +202,31 @@ func TestRouter_HandlerInterface(t *testing.T) {
 	r.Get("/ping", okHandler)
 	var _ http.Handler = r.Handler()
 }
+
+// ── Concurrency ──────────────────────────────────────────────────────────────
+
+func TestRouter_ServeWhileRegistering(t *testing.T) {
+	r := routing.New()
+	r.Get("/ping", okHandler)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if rr := do(t, r, http.MethodGet, "/ping"); rr.Code != http.StatusOK {
+					t.Errorf("got %d want 200", rr.Code)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		r.Get("/extra/"+string(rune('a'+i)), okHandler)
+	}
+	wg.Wait()
+	if rr := do(t, r, http.MethodGet, "/extra/t"); rr.Code != http.StatusOK {
+		t.Errorf("late route: got %d want 200", rr.Code)
+	}
+}
@@ -0,0 +1,157 @@
+package routing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	gohttp "github.com/km-arc/go-laravel/framework/http"
+)
+
+// ── Signed URLs ──────────────────────────────────────────────────────────────
+
+// SetSigningKey sets the HMAC key for signed URLs — usually APP_KEY.
+// An empty key is ignored, keeping the current one.
+func (r *Router) SetSigningKey(key string) {
+	if key == "" {
+		return
+	}
+	b := []byte(key)
+	r.routes.signingKey.Store(&b)
+}
+
+// SignedURL generates an absolute URL for a named route with a signature
+// that ValidateSignature checks.
+//
+//	// Laravel: URL::signedRoute('unsubscribe', ['user' => 1])
+//	u, err := r.SignedURL("unsubscribe", map[string]any{"user": 1})
+func (r *Router) SignedURL(name string, params map[string]any) (string, error) {
+	return r.TemporarySignedURL(name, time.Time{}, params)
+}
+
+// TemporarySignedURL is like SignedURL but the link stops validating after
+// expires.
+//
+//	// Laravel: URL::temporarySignedRoute('verification.verify', now()->addMinutes(60), [...])
+//	u, err := r.TemporarySignedURL("verification.verify", time.Now().Add(time.Hour), params)
+func (r *Router) TemporarySignedURL(name string, expires time.Time, params map[string]any) (string, error) {
+	u, err := r.URL(name, params)
+	if err != nil {
+		return "", err
+	}
+	return r.SignURL(u, expires), nil
+}
+
+// SignURL signs an arbitrary absolute URL or path; a zero expires never
+// expires. Sign a path (not a full URL) for use with ValidateRelativeSignature.
+func (r *Router) SignURL(rawURL string, expires time.Time) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	q.Del("signature")
+	if !expires.IsZero() {
+		q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	}
+	u.RawQuery = q.Encode()
+
+	q.Set("signature", r.signature(u.String()))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// HasValidSignature reports whether req carries a valid, unexpired signature.
+// With absolute=false only the path and query are checked, which is useful
+// behind proxies that rewrite the host.
+//
+//	// Laravel: $request->hasValidSignature()
+func (r *Router) HasValidSignature(req *http.Request, absolute bool) bool {
+	q := req.URL.Query()
+	sig := q.Get("signature")
+	if sig == "" || r.routes.key() == nil {
+		return false
+	}
+	q.Del("signature")
+
+	u := url.URL{Path: req.URL.Path, RawQuery: q.Encode()}
+	if absolute {
+		u.Scheme, u.Host = gohttp.Scheme(req), req.Host
+	}
+	if !hmac.Equal([]byte(sig), []byte(r.signature(u.String()))) {
+		return false
+	}
+	if exp := q.Get("expires"); exp != "" {
+		ts, err := strconv.ParseInt(exp, 10, 64)
+		if err != nil || time.Now().Unix() > ts {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateSignature returns middleware that rejects requests with a missing,
+// tampered or expired signature with 403.
+//
+//	// Laravel: Route::get(...)->middleware('signed')
+//	r.Group(func(g *routing.Router) {
+//	    g.Middleware(r.ValidateSignature())
+//	    g.Get("/unsubscribe/{user}", unsubscribe).Name("unsubscribe")
+//	})
+//
+// It is also registered as the "signed" alias:
+//
+//	r.Get("/unsubscribe/{user}", unsubscribe).Name("unsubscribe").Middleware("signed")
+func (r *Router) ValidateSignature() func(http.Handler) http.Handler {
+	return r.validateSignature(true)
+}
+
+// ValidateRelativeSignature is ValidateSignature for URLs signed as paths.
+//
+//	// Laravel: ->middleware('signed:relative')
+func (r *Router) ValidateRelativeSignature() func(http.Handler) http.Handler {
+	return r.validateSignature(false)
+}
+
+// validateSignature panics when wrapping a route without a signing key, as
+// the routes compile, rather than on each request.
+func (r *Router) validateSignature(absolute bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if r.routes.key() == nil {
+			panic(errNoSigningKey)
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if !r.HasValidSignature(req, absolute) {
+				gohttp.NewResponse(w).Forbidden("Invalid signature.")
+				return
+			}
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+// signature returns the hex HMAC-SHA256 of s under the signing key.
+// Like Laravel's MissingAppKeyException, it refuses to sign with no key.
+func (r *Router) signature(s string) string {
+	key := r.routes.key()
+	if key == nil {
+		panic(errNoSigningKey)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(s))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+const errNoSigningKey = "routing: no signing key set — configure APP_KEY"
+
+// key returns the signing key, nil when none is set.
+func (c *routeCollection) key() []byte {
+	if key := c.signingKey.Load(); key != nil {
+		return *key
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package routing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func signedRouter(t *testing.T) *routing.Router {
+	t.Helper()
+	r := routing.New()
+	r.SetBaseURL("http://example.com")
+	r.SetSigningKey("base64:test-key")
+	r.Group(func(g *routing.Router) {
+		g.Middleware(r.ValidateSignature())
+		g.Get("/unsubscribe/{user}", okHandler).Name("unsubscribe")
+	})
+	return r
+}
+
+func get(t *testing.T, r *routing.Router, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+	return rr
+}
+
+// ── Signed URLs ───────────────────────────────────────────────────────────────
+
+func TestSignedURL_Valid(t *testing.T) {
+	r := signedRouter(t)
+	u, err := r.SignedURL("unsubscribe", map[string]any{"user": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(u, "signature=") {
+		t.Fatalf("expected signature in %q", u)
+	}
+	if rr := get(t, r, u); rr.Code != http.StatusOK {
+		t.Errorf("valid signature: got %d want 200", rr.Code)
+	}
+}
+
+func TestSignedURL_Tampered(t *testing.T) {
+	r := signedRouter(t)
+	u, _ := r.SignedURL("unsubscribe", map[string]any{"user": 1})
+	tampered := strings.Replace(u, "/unsubscribe/1", "/unsubscribe/2", 1)
+
+	if rr := get(t, r, tampered); rr.Code != http.StatusForbidden {
+		t.Errorf("tampered: got %d want 403", rr.Code)
+	}
+	if rr := get(t, r, "http://example.com/unsubscribe/1"); rr.Code != http.StatusForbidden {
+		t.Errorf("unsigned: got %d want 403", rr.Code)
+	}
+}
+
+func TestTemporarySignedURL_Expiry(t *testing.T) {
+	r := signedRouter(t)
+
+	live, _ := r.TemporarySignedURL("unsubscribe", time.Now().Add(time.Hour), map[string]any{"user": 1})
+	if rr := get(t, r, live); rr.Code != http.StatusOK {
+		t.Errorf("unexpired: got %d want 200", rr.Code)
+	}
+
+	expired, _ := r.TemporarySignedURL("unsubscribe", time.Now().Add(-time.Minute), map[string]any{"user": 1})
+	if rr := get(t, r, expired); rr.Code != http.StatusForbidden {
+		t.Errorf("expired: got %d want 403", rr.Code)
+	}
+}
+
+func TestValidateRelativeSignature_IgnoresHost(t *testing.T) {
+	r := routing.New()
+	r.SetSigningKey("secret")
+	r.Group(func(g *routing.Router) {
+		g.Middleware(r.ValidateRelativeSignature())
+		g.Get("/relative", okHandler)
+	})
+
+	signed := r.SignURL("/relative?id=5", time.Time{})
+	if rr := get(t, r, "http://other-host"+signed); rr.Code != http.StatusOK {
+		t.Errorf("relative signature: got %d want 200", rr.Code)
+	}
+}
@@ -0,0 +1,276 @@
+package routing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ── Static files ─────────────────────────────────────────────────────────────
+
+// StaticOption customises Static.
+type StaticOption func(*staticOptions)
+
+type staticOptions struct {
+	maxAge   time.Duration
+	manifest string
+}
+
+// MaxAge lets browsers reuse files for d without revalidating. By default
+// they revalidate every time, using the ETag.
+//
+//	r.Static("/public", "./public", routing.MaxAge(time.Hour))
+func MaxAge(d time.Duration) StaticOption {
+	return func(o *staticOptions) { o.maxAge = d }
+}
+
+// Manifest reads an asset manifest from the served files, for Router.Asset.
+// Both Laravel Mix ({"/js/app.js": "/js/app.js?id=abc"}) and Vite
+// ({"resources/js/app.js": {"file": "assets/app-abc.js"}}) manifests work.
+// Versioned URLs are cached for a year. The manifest is read once.
+//
+//	r.Static("/build", "./public/build", routing.Manifest("manifest.json"))
+func Manifest(name string) StaticOption {
+	return func(o *staticOptions) { o.manifest = name }
+}
+
+// immutable is the Cache-Control of versioned assets.
+const immutable = "public, max-age=31536000, immutable"
+
+// Static serves a directory or an fs.FS, such as an embed.FS, at prefix.
+// Directories serve their index.html and are never listed. Responses carry
+// an ETag and a Cache-Control header (see MaxAge and Manifest), and
+// clients accepting it get the precompressed "file.br" or "file.gz" next
+// to a file.
+//
+// The handler is registered under "static:" and the URL prefix, so the
+// routes can be cached (see Router.Cache); when routes were loaded from
+// the cache, only the handler is registered. Call Static outside the
+// callback given to app.Routes, like openapi.Register.
+//
+//	// Laravel: the public directory
+//	r.Static("/public", "./public")
+//
+//	//go:embed public
+//	var public embed.FS
+//	sub, _ := fs.Sub(public, "public")
+//	r.Static("/assets", sub, routing.MaxAge(24*time.Hour))
+func (r *Router) Static(prefix string, root any, opts ...StaticOption) {
+	var o staticOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var fsys fs.FS
+	switch root := root.(type) {
+	case string:
+		fsys = os.DirFS(root)
+	case fs.FS:
+		fsys = root
+	default:
+		panic(fmt.Sprintf("routing: Static needs a directory or an fs.FS, got %T", root))
+	}
+
+	full := joinPath(r.group.fullPrefix(), prefix)
+	files := &staticFiles{fsys: fsys, maxAge: o.maxAge, routes: r.routes}
+	if o.manifest != "" {
+		m, err := readManifest(fsys, o.manifest, full)
+		if err != nil {
+			panic(fmt.Sprintf("routing: static manifest %s: %v", o.manifest, err))
+		}
+		files.manifest = m
+		r.routes.mu.Lock()
+		r.routes.manifests = append(r.routes.manifests, m)
+		r.routes.mu.Unlock()
+	}
+
+	key := "static:" + r.group.fullDomain() + full
+	r.RegisterHandler(key, files)
+	if r.Cached() {
+		return
+	}
+	r.Match([]string{"GET", "HEAD"}, joinPath(prefix, "*"), key).Undocumented()
+}
+
+// Asset returns the versioned URL of a file listed in a Static manifest.
+//
+//	// Laravel: mix('/js/app.js'), Vite::asset('resources/js/app.js')
+//	r.Asset("js/app.js") // "/public/js/app.js?id=abc"
+func (r *Router) Asset(name string) (string, error) {
+	name = strings.TrimPrefix(name, "/")
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	for _, m := range r.routes.manifests {
+		if url, ok := m.urls[name]; ok {
+			return url, nil
+		}
+	}
+	return "", fmt.Errorf("routing: unable to locate asset [%s] in any manifest", name)
+}
+
+// manifest maps asset names to versioned URLs.
+type manifest struct {
+	urls      map[string]string // "js/app.js" → "/public/js/app.js?id=abc"
+	versioned map[string]bool   // "js/app.js?id=abc", relative to the prefix
+}
+
+// readManifest reads a Mix or Vite manifest served at prefix.
+func readManifest(fsys fs.FS, name, prefix string) (*manifest, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	m := &manifest{urls: make(map[string]string), versioned: make(map[string]bool)}
+	for key, value := range raw {
+		var file string
+		if json.Unmarshal(value, &file) != nil {
+			var chunk struct {
+				File string `json:"file"`
+			}
+			if err := json.Unmarshal(value, &chunk); err != nil {
+				return nil, fmt.Errorf("entry %q: %w", key, err)
+			}
+			file = chunk.File
+		}
+		key, file = strings.TrimPrefix(key, "/"), strings.TrimPrefix(file, "/")
+		m.urls[key] = joinPath(prefix, file)
+		if file != key {
+			m.versioned[file] = true
+		}
+	}
+	return m, nil
+}
+
+// staticFiles serves the files behind a Static route.
+type staticFiles struct {
+	fsys     fs.FS
+	maxAge   time.Duration
+	manifest *manifest
+	routes   *routeCollection
+	etags    sync.Map // name → ETag of files without a modification time
+}
+
+// encodings are the precompressed variants, preferred first.
+var encodings = []struct{ name, ext string }{{"br", ".br"}, {"gzip", ".gz"}}
+
+func (s *staticFiles) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := path.Clean("/" + chi.URLParam(r, "*"))[1:]
+	if name == "" {
+		name = "."
+	}
+	info, err := fs.Stat(s.fsys, name)
+	if err == nil && info.IsDir() {
+		name = path.Join(name, "index.html")
+		info, err = fs.Stat(s.fsys, name)
+	}
+	if err != nil || info.IsDir() {
+		s.routes.respondStatus(w, r, http.StatusNotFound, "")
+		return
+	}
+
+	w.Header().Add("Vary", "Accept-Encoding")
+	served := name
+	for _, enc := range encodings {
+		if !acceptsEncoding(r.Header.Get("Accept-Encoding"), enc.name) {
+			continue
+		}
+		if vi, err := fs.Stat(s.fsys, name+enc.ext); err == nil && !vi.IsDir() {
+			served, info = name+enc.ext, vi
+			w.Header().Set("Content-Encoding", enc.name)
+			break
+		}
+	}
+
+	f, err := s.fsys.Open(served)
+	if err != nil {
+		s.routes.respondStatus(w, r, http.StatusNotFound, "")
+		return
+	}
+	defer f.Close()
+	content, ok := f.(io.ReadSeeker)
+	if !ok {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			s.routes.respondStatus(w, r, http.StatusInternalServerError, "")
+			return
+		}
+		content = bytes.NewReader(data)
+	}
+
+	etag, err := s.etag(served, info, content)
+	if err != nil {
+		s.routes.respondStatus(w, r, http.StatusInternalServerError, "")
+		return
+	}
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", s.cacheControl(name, r.URL.RawQuery))
+	// The original name gives the Content-Type of a compressed variant.
+	http.ServeContent(w, r, name, info.ModTime(), content)
+}
+
+// etag identifies a file by size and modification time, or by content when
+// it has no modification time, as in an embed.FS.
+func (s *staticFiles) etag(name string, info fs.FileInfo, content io.ReadSeeker) (string, error) {
+	if !info.ModTime().IsZero() {
+		return fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size()), nil
+	}
+	if etag, ok := s.etags.Load(name); ok {
+		return etag.(string), nil
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, content); err != nil {
+		return "", err
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	etag := `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+	s.etags.Store(name, etag)
+	return etag, nil
+}
+
+// cacheControl returns the Cache-Control header for a file.
+func (s *staticFiles) cacheControl(name, query string) string {
+	if s.manifest != nil {
+		versioned := name
+		if query != "" {
+			versioned += "?" + query
+		}
+		if s.manifest.versioned[versioned] {
+			return immutable
+		}
+	}
+	if s.maxAge > 0 {
+		return "public, max-age=" + strconv.Itoa(int(s.maxAge.Seconds()))
+	}
+	return "no-cache"
+}
+
+// acceptsEncoding reports whether an Accept-Encoding header allows enc.
+func acceptsEncoding(header, enc string) bool {
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), enc) {
+			continue
+		}
+		q := strings.ReplaceAll(strings.TrimSpace(params), " ", "")
+		return q != "q=0" && q != "q=0.0" && q != "q=0.00" && q != "q=0.000"
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+package routing_test
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+func assets() fstest.MapFS {
+	return fstest.MapFS{
+		"app.css":         {Data: []byte("body{}")},
+		"app.js":          {Data: []byte("plain")},
+		"app.js.br":       {Data: []byte("brotli")},
+		"app.js.gz":       {Data: []byte("gzipped")},
+		"docs/index.html": {Data: []byte("<h1>Docs</h1>")},
+		"images/logo.svg": {Data: []byte("<svg/>")},
+		"mix-manifest.json": {Data: []byte(`{
+			"/app.js": "/app.js?id=abc123",
+			"/app.css": "/app.css"
+		}`)},
+	}
+}
+
+// ── Serving ──────────────────────────────────────────────────────────────────
+
+func TestStatic_Dir(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "public"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "public", "app.css"), []byte("body{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "secret.txt"), []byte("secret"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r := routing.New()
+	r.Static("/public", filepath.Join(dir, "public"))
+
+	rr := do(t, r, http.MethodGet, "/public/app.css")
+	if rr.Code != http.StatusOK || rr.Body.String() != "body{}" || !strings.HasPrefix(rr.Header().Get("Content-Type"), "text/css") {
+		t.Errorf("got %d %q %q", rr.Code, rr.Body.String(), rr.Header().Get("Content-Type"))
+	}
+	if rr.Header().Get("ETag") == "" || rr.Header().Get("Cache-Control") != "no-cache" {
+		t.Errorf("headers: %v", rr.Header())
+	}
+	if rr := do(t, r, http.MethodGet, "/public/..%2fsecret.txt"); rr.Code != http.StatusNotFound {
+		t.Errorf("traversal: got %d want 404", rr.Code)
+	}
+}
+
+func TestStatic_FSAndETag(t *testing.T) {
+	r := routing.New()
+	r.Static("/assets", assets())
+
+	rr := do(t, r, http.MethodGet, "/assets/images/logo.svg")
+	etag := rr.Header().Get("ETag")
+	if rr.Code != http.StatusOK || rr.Body.String() != "<svg/>" || etag == "" {
+		t.Fatalf("got %d %q ETag %q", rr.Code, rr.Body.String(), etag)
+	}
+	rr = doHeaders(t, r, http.MethodGet, "/assets/images/logo.svg", map[string]string{"If-None-Match": etag})
+	if rr.Code != http.StatusNotModified {
+		t.Errorf("If-None-Match: got %d want 304", rr.Code)
+	}
+	if rr := do(t, r, http.MethodHead, "/assets/app.css"); rr.Code != http.StatusOK {
+		t.Errorf("HEAD: got %d", rr.Code)
+	}
+}
+
+func TestStatic_NoDirectoryListing(t *testing.T) {
+	r := routing.New()
+	r.Static("/assets", assets())
+
+	if got := do(t, r, http.MethodGet, "/assets/docs/").Body.String(); got != "<h1>Docs</h1>" {
+		t.Errorf("index: got %q", got)
+	}
+	for _, path := range []string{"/assets/images/", "/assets/", "/assets/missing.txt"} {
+		if rr := doJSON(t, r, http.MethodGet, path); rr.Code != http.StatusNotFound {
+			t.Errorf("%s: got %d want 404", path, rr.Code)
+		}
+	}
+}
+
+func TestStatic_Precompressed(t *testing.T) {
+	r := routing.New()
+	r.Static("/assets", assets())
+
+	cases := []struct {
+		accept, body, encoding string
+	}{
+		{"", "plain", ""},
+		{"gzip", "gzipped", "gzip"},
+		{"gzip, deflate, br", "brotli", "br"},
+		{"br;q=0, gzip", "gzipped", "gzip"},
+	}
+	for _, tc := range cases {
+		rr := doHeaders(t, r, http.MethodGet, "/assets/app.js", map[string]string{"Accept-Encoding": tc.accept})
+		if rr.Body.String() != tc.body || rr.Header().Get("Content-Encoding") != tc.encoding {
+			t.Errorf("%q: got %q %q", tc.accept, rr.Body.String(), rr.Header().Get("Content-Encoding"))
+		}
+		if !strings.Contains(rr.Header().Get("Content-Type"), "javascript") || rr.Header().Get("Vary") != "Accept-Encoding" {
+			t.Errorf("%q: headers %v", tc.accept, rr.Header())
+		}
+	}
+}
+
+// ── Caching ──────────────────────────────────────────────────────────────────
+
+func TestStatic_MaxAge(t *testing.T) {
+	r := routing.New()
+	r.Static("/assets", assets(), routing.MaxAge(time.Hour))
+	if got := do(t, r, http.MethodGet, "/assets/app.css").Header().Get("Cache-Control"); got != "public, max-age=3600" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestStatic_Manifest(t *testing.T) {
+	r := routing.New()
+	r.Static("/public", assets(), routing.Manifest("mix-manifest.json"))
+
+	url, err := r.Asset("app.js")
+	if err != nil || url != "/public/app.js?id=abc123" {
+		t.Fatalf("Asset: got %q, %v", url, err)
+	}
+	if got := do(t, r, http.MethodGet, url).Header().Get("Cache-Control"); got != "public, max-age=31536000, immutable" {
+		t.Errorf("versioned: got %q", got)
+	}
+	if got := do(t, r, http.MethodGet, "/public/app.css").Header().Get("Cache-Control"); got != "no-cache" {
+		t.Errorf("unversioned: got %q", got)
+	}
+	if _, err := r.Asset("missing.js"); err == nil {
+		t.Error("expected an error for an unknown asset")
+	}
+
+	var buf bytes.Buffer
+	tpl := template.Must(template.New("t").Funcs(r.TemplateFuncs()).Parse(`<script src="{{ asset "/app.js" }}"></script>`))
+	if err := tpl.Execute(&buf, nil); err != nil || buf.String() != `<script src="/public/app.js?id=abc123"></script>` {
+		t.Errorf("template: got %q, %v", buf.String(), err)
+	}
+}
+
+func TestStatic_ViteManifest(t *testing.T) {
+	fsys := fstest.MapFS{
+		"assets/app-4ed993c7.js": {Data: []byte("app")},
+		"manifest.json":          {Data: []byte(`{"resources/js/app.js": {"file": "assets/app-4ed993c7.js", "isEntry": true}}`)},
+	}
+	r := routing.New()
+	r.Static("/build", fsys, routing.Manifest("manifest.json"))
+
+	url, err := r.Asset("resources/js/app.js")
+	if err != nil || url != "/build/assets/app-4ed993c7.js" {
+		t.Fatalf("Asset: got %q, %v", url, err)
+	}
+	if got := do(t, r, http.MethodGet, url).Header().Get("Cache-Control"); got != "public, max-age=31536000, immutable" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestStatic_Cached(t *testing.T) {
+	src := routing.New()
+	src.Static("/assets", assets())
+	var buf bytes.Buffer
+	if err := src.Cache(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	r := routing.New()
+	if err := r.LoadCache(&buf); err != nil {
+		t.Fatal(err)
+	}
+	r.Static("/assets", assets())
+	if got := do(t, r, http.MethodGet, "/assets/app.css").Body.String(); got != "body{}" {
+		t.Errorf("got %q", got)
+	}
+	if infos := r.List(routing.RouteFilter{}); len(infos) != 1 || infos[0].Action != "static:/assets" {
+		t.Errorf("list: %+v", infos)
+	}
+}
@@ -0,0 +1,239 @@
+package routing
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ── URL generation ───────────────────────────────────────────────────────────
+
+// SetBaseURL sets the root used by URL for absolute links — usually APP_URL.
+//
+//	// Laravel: URL::forceRootUrl(config('app.url'))
+//	r.SetBaseURL(cfg.App.URL)
+func (r *Router) SetBaseURL(base string) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.baseURL = strings.TrimRight(base, "/")
+}
+
+// BaseURL returns the root set with SetBaseURL ("" if unset).
+func (r *Router) BaseURL() string {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	return r.routes.baseURL
+}
+
+// Route returns the route registered under name, or nil.
+func (r *Router) Route(name string) *Route {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	return r.routes.byName[name]
+}
+
+// HasRoute reports whether a route with the given name exists.
+//
+//	// Laravel: Route::has('users.show')
+func (r *Router) HasRoute(name string) bool { return r.Route(name) != nil }
+
+// URL generates an absolute URL for a named route, prefixed with the base URL.
+// Route parameters are filled from params; the remaining params are appended
+// as a query string. Routes registered with Domain use their own host, with
+// the base URL's scheme and port.
+//
+//	// Laravel: route('users.show', ['id' => 42, 'tab' => 'posts'])
+//	u, err := r.URL("users.show", map[string]any{"id": 42, "tab": "posts"})
+//	// "http://localhost/users/42?tab=posts"
+func (r *Router) URL(name string, params map[string]any) (string, error) {
+	host, path, err := r.generate(name, params)
+	if err != nil {
+		return "", err
+	}
+	base := r.BaseURL()
+	if host == "" {
+		return base + path, nil
+	}
+	scheme, port := "http", ""
+	if u, err := url.Parse(base); err == nil && u.Scheme != "" {
+		scheme = u.Scheme
+		if u.Port() != "" {
+			port = ":" + u.Port()
+		}
+	}
+	return scheme + "://" + host + port + path, nil
+}
+
+// Path is like URL but returns a relative path.
+//
+//	// Laravel: route('users.show', ['id' => 42], false)
+//	p, err := r.Path("users.show", map[string]any{"id": 42}) // "/users/42"
+func (r *Router) Path(name string, params map[string]any) (string, error) {
+	_, path, err := r.generate(name, params)
+	return path, err
+}
+
+// generate fills a named route's domain (empty without one) and path.
+func (r *Router) generate(name string, params map[string]any) (host, path string, err error) {
+	r.routes.mu.Lock()
+	rt := r.routes.byName[name]
+	var domain, pattern string
+	if rt != nil {
+		domain, pattern = r.routes.constrainedDomain(rt), r.routes.constrained(rt)
+	}
+	r.routes.mu.Unlock()
+	if rt == nil {
+		return "", "", fmt.Errorf("routing: route [%s] not defined", name)
+	}
+
+	// Fill both at once so domain parameters are not repeated in the query.
+	full, err := fillPattern(domain+pattern, name, params)
+	if err != nil {
+		return "", "", err
+	}
+	i := strings.IndexByte(full, '/')
+	return full[:i], full[i:], nil
+}
+
+// TemplateFuncs returns template helpers bound to this router:
+//
+//	{{ route "users.show" "id" .User.ID }}      → absolute URL
+//	{{ route "users.index" .Params }}           → params from a map[string]any
+//	{{ asset "js/app.js" }}                     → versioned URL, see Manifest
+func (r *Router) TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"asset": r.Asset,
+		"route": func(name string, args ...any) (string, error) {
+			params, err := pairs(args)
+			if err != nil {
+				return "", err
+			}
+			return r.URL(name, params)
+		},
+	}
+}
+
+// fillPattern substitutes {param} / {param:regex} segments and a trailing "*"
+// in a chi pattern, appending unused params as a sorted query string.
+// Values must satisfy the parameter's regex.
+func fillPattern(pattern, name string, params map[string]any) (string, error) {
+	used := make(map[string]bool, len(params))
+	var b strings.Builder
+
+	for _, part := range splitPattern(pattern) {
+		switch {
+		case part.param == "":
+			b.WriteString(part.literal)
+		case part.param == "*":
+			if v, ok := params["*"]; ok {
+				used["*"] = true
+				b.WriteString(fmt.Sprint(v))
+			}
+		default:
+			v, ok := params[part.param]
+			if !ok {
+				return "", fmt.Errorf("routing: missing required parameter [%s] for route [%s]", part.param, name)
+			}
+			s := fmt.Sprint(v)
+			if part.regex != "" {
+				if ok, _ := regexp.MatchString("^(?:"+part.regex+")$", s); !ok {
+					return "", fmt.Errorf("routing: parameter [%s] for route [%s] must match %q, got %q",
+						part.param, name, part.regex, s)
+				}
+			}
+			used[part.param] = true
+			b.WriteString(url.PathEscape(s))
+		}
+	}
+
+	query := url.Values{}
+	for k, v := range params {
+		if !used[k] {
+			query.Set(k, fmt.Sprint(v))
+		}
+	}
+	if len(query) > 0 {
+		b.WriteString("?" + query.Encode())
+	}
+	return b.String(), nil
+}
+
+// patternPart is a literal run or a parameter of a chi pattern.
+type patternPart struct {
+	literal string
+	param   string // "" for literals, "*" for a trailing wildcard
+	regex   string
+}
+
+// splitPattern breaks a chi pattern into literals and {param[:regex]}
+// segments; regexes may contain nested braces, e.g. {code:[a-z]{3}}.
+func splitPattern(pattern string) []patternPart {
+	var parts []patternPart
+	lit := 0
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case pattern[i] == '{':
+			end, depth := i, 0
+			for ; end < len(pattern); end++ {
+				if pattern[end] == '{' {
+					depth++
+				} else if pattern[end] == '}' {
+					if depth--; depth == 0 {
+						break
+					}
+				}
+			}
+			if lit < i {
+				parts = append(parts, patternPart{literal: pattern[lit:i]})
+			}
+			key, regex, _ := strings.Cut(pattern[i+1:min(end, len(pattern))], ":")
+			parts = append(parts, patternPart{param: key, regex: regex})
+			i, lit = end, end+1
+		case pattern[i] == '*' && i == len(pattern)-1:
+			if lit < i {
+				parts = append(parts, patternPart{literal: pattern[lit:i]})
+			}
+			parts = append(parts, patternPart{param: "*"})
+			lit = len(pattern)
+		}
+	}
+	if lit < len(pattern) {
+		parts = append(parts, patternPart{literal: pattern[lit:]})
+	}
+	return parts
+}
+
+// paramNames returns the {param} names in a chi pattern, in order.
+func paramNames(pattern string) []string {
+	var names []string
+	for _, part := range splitPattern(pattern) {
+		if part.param != "" && part.param != "*" {
+			names = append(names, part.param)
+		}
+	}
+	return names
+}
+
+// pairs converts template arguments into a params map: either a single
+// map[string]any or alternating key / value arguments.
+func pairs(args []any) (map[string]any, error) {
+	if len(args) == 1 {
+		if m, ok := args[0].(map[string]any); ok {
+			return m, nil
+		}
+	}
+	if len(args)%2 != 0 {
+		return nil, fmt.Errorf("routing: route params must be key/value pairs, got %d args", len(args))
+	}
+	params := make(map[string]any, len(args)/2)
+	for i := 0; i < len(args); i += 2 {
+		key, ok := args[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("routing: route param key %v is not a string", args[i])
+		}
+		params[key] = args[i+1]
+	}
+	return params, nil
+}
@@ -0,0 +1,117 @@
+package routing_test
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"testing"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── Named routes ──────────────────────────────────────────────────────────────
+
+func TestRoute_Name(t *testing.T) {
+	r := routing.New()
+	rt := r.Get("/users/{id}", okHandler).Name("users.show")
+
+	if rt.GetName() != "users.show" {
+		t.Errorf("GetName: got %q", rt.GetName())
+	}
+	if !r.HasRoute("users.show") {
+		t.Error("HasRoute(users.show) should be true")
+	}
+	if r.HasRoute("missing") {
+		t.Error("HasRoute(missing) should be false")
+	}
+}
+
+func TestRoute_NamePrefixInherited(t *testing.T) {
+	r := routing.New()
+	r.As("admin.").Prefix("/admin", func(admin *routing.Router) {
+		admin.Group(func(g *routing.Router) {
+			g.As("users.").Get("/users", okHandler).Name("index")
+		})
+	})
+
+	rt := r.Route("admin.users.index")
+	if rt == nil {
+		t.Fatal("expected route admin.users.index")
+	}
+	if rt.URI() != "/admin/users" {
+		t.Errorf("URI: got %q want %q", rt.URI(), "/admin/users")
+	}
+	if rr := do(t, r, http.MethodGet, "/admin/users"); rr.Code != http.StatusOK {
+		t.Errorf("GET /admin/users: got %d want 200", rr.Code)
+	}
+}
+
+func TestRouter_Resource_Names(t *testing.T) {
+	r := routing.New()
+	r.Resource("/photos", &stubController{})
+
+	for _, name := range []string{"photos.index", "photos.store", "photos.show", "photos.update", "photos.destroy"} {
+		if !r.HasRoute(name) {
+			t.Errorf("expected resource route %q", name)
+		}
+	}
+}
+
+// ── URL generation ────────────────────────────────────────────────────────────
+
+func TestRouter_Path_FillsParamsAndQuery(t *testing.T) {
+	r := routing.New()
+	r.Prefix("/api", func(api *routing.Router) {
+		api.Get("/users/{id}/posts/{post:[0-9]+}", okHandler).Name("posts.show")
+	})
+
+	got, err := r.Path("posts.show", map[string]any{"id": 7, "post": 42, "tab": "comments", "page": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/api/users/7/posts/42?page=2&tab=comments"; got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
+
+func TestRouter_URL_UsesBaseURL(t *testing.T) {
+	r := routing.New()
+	r.SetBaseURL("https://example.com/")
+	r.Get("/users/{id}", okHandler).Name("users.show")
+
+	got, err := r.URL("users.show", map[string]any{"id": "a b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://example.com/users/a%20b"; got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
+
+func TestRouter_URL_Errors(t *testing.T) {
+	r := routing.New()
+	r.Get("/users/{id}", okHandler).Name("users.show")
+
+	if _, err := r.URL("nope", nil); err == nil {
+		t.Error("expected error for undefined route")
+	}
+	if _, err := r.URL("users.show", nil); err == nil {
+		t.Error("expected error for missing parameter")
+	}
+}
+
+func TestRouter_TemplateFuncs(t *testing.T) {
+	r := routing.New()
+	r.SetBaseURL("http://localhost")
+	r.Get("/users/{id}", okHandler).Name("users.show")
+
+	tmpl := template.Must(template.New("t").Funcs(r.TemplateFuncs()).
+		Parse(`<a href="{{ route "users.show" "id" 5 }}">`))
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := `<a href="http://localhost/users/5">`; buf.String() != want {
+		t.Errorf("got %q want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,387 @@
+package routing
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ── API versioning ───────────────────────────────────────────────────────────
+
+// APIVersion is a version registered with Router.Version. Chain Default,
+// Deprecated or Sunset on it.
+type APIVersion struct {
+	name        string
+	set         *versionSet
+	deprecation string // Deprecation header value; "" while supported
+	sunset      string // Sunset header value (HTTP-date)
+}
+
+// versionSet holds the versions registered under one domain and prefix,
+// oldest first.
+type versionSet struct {
+	domain   string
+	prefix   string // without trailing slash, e.g. "/api"
+	versions []*APIVersion
+	def      *APIVersion // see APIVersion.Default; nil means the latest
+	routes   *routeCollection
+}
+
+// Version creates a group for one version of an API, prefixed and named
+// after it: in a Prefix("/api") group, Version("v2", fn) serves fn's routes
+// at /api/v2/... and names them "v2.…".
+//
+//	// Laravel: Route::prefix('v2')->name('v2.')->group(fn)
+//	r.Prefix("/api", func(api *routing.Router) {
+//	    api.Version("v1", func(v1 *routing.Router) {
+//	        v1.Get("/users", listUsersV1).Name("users.index")
+//	        v1.Get("/users/{id}", showUser).Name("users.show")
+//	    }).Sunset(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+//	    api.Version("v2", func(v2 *routing.Router) {
+//	        v2.Get("/users", listUsersV2).Name("users.index")
+//	    })
+//	})
+//
+// Versions fall back to the ones registered before them: an endpoint v2
+// does not define, such as GET /api/v2/users/{id} above, is served by v1's
+// handler and middleware.
+//
+// Requests without a version in the URL (GET /api/users) are served by the
+// version named in the Accept header (application/vnd.app.v2+json) or the
+// X-API-Version header ("v2" or "2"), otherwise the Default version; see
+// VersionNegotiation. Routes registered outside the versions take
+// precedence over these.
+func (r *Router) Version(name string, fn func(r *Router)) *APIVersion {
+	v := r.routes.apiVersion(r.group.fullDomain(), r.group.fullPrefix(), name)
+	g := r.group.child()
+	g.prefix = name
+	g.namePrefix = name + "."
+	g.version = v
+	fn(r.derive(g))
+	return v
+}
+
+// VersionNegotiation sets the vendor in Accept media types
+// (application/vnd.{vendor}.v2+json) and the header that select a version
+// for unversioned URLs. The defaults are "app" and "X-API-Version".
+func (r *Router) VersionNegotiation(vendor, header string) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.versionVendor = strings.ToLower(vendor)
+	r.routes.versionHeader = header
+	r.routes.mux.Store(nil)
+}
+
+// Name returns the version's name, e.g. "v2".
+func (v *APIVersion) Name() string { return v.name }
+
+// Default makes this version serve unversioned requests that do not ask
+// for one, instead of the latest.
+func (v *APIVersion) Default() *APIVersion {
+	return v.update(func() { v.set.def = v })
+}
+
+// Deprecated marks the version deprecated: its responses carry a
+// Deprecation header (RFC 9745), "@<unix time>" when since is given and
+// "true" otherwise.
+//
+//	api.Version("v1", fn).Deprecated(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+func (v *APIVersion) Deprecated(since ...time.Time) *APIVersion {
+	value := "true"
+	if len(since) > 0 {
+		value = "@" + strconv.FormatInt(since[0].Unix(), 10)
+	}
+	return v.update(func() { v.deprecation = value })
+}
+
+// Sunset announces when the version stops working with a Sunset header
+// (RFC 8594), and marks it deprecated if it is not already.
+func (v *APIVersion) Sunset(at time.Time) *APIVersion {
+	return v.update(func() {
+		v.sunset = at.UTC().Format(http.TimeFormat)
+		if v.deprecation == "" {
+			v.deprecation = "true"
+		}
+	})
+}
+
+func (v *APIVersion) update(fn func()) *APIVersion {
+	c := v.set.routes
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn()
+	c.mux.Store(nil)
+	return v
+}
+
+// fullVersion returns the innermost version set on the group chain.
+func (g *group) fullVersion() *APIVersion {
+	if g == nil {
+		return nil
+	}
+	if g.version != nil {
+		return g.version
+	}
+	return g.parent.fullVersion()
+}
+
+// apiVersion returns the named version under domain and prefix, adding it
+// as the latest if it is new.
+func (c *routeCollection) apiVersion(domain, prefix, name string) *APIVersion {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.apiVersionLocked(domain, prefix, name)
+}
+
+// apiVersionLocked implements apiVersion (must hold mu).
+func (c *routeCollection) apiVersionLocked(domain, prefix, name string) *APIVersion {
+	prefix = strings.TrimRight(prefix, "/")
+	var set *versionSet
+	for _, s := range c.versionSets {
+		if s.domain == domain && s.prefix == prefix {
+			set = s
+		}
+	}
+	if set == nil {
+		set = &versionSet{domain: domain, prefix: prefix, routes: c}
+		c.versionSets = append(c.versionSets, set)
+	}
+	for _, v := range set.versions {
+		if v.name == name {
+			return v
+		}
+	}
+	v := &APIVersion{name: name, set: set}
+	set.versions = append(set.versions, v)
+	c.mux.Store(nil)
+	return v
+}
+
+// ── compilation ──────────────────────────────────────────────────────────────
+
+// versionEndpoint is one method and path of a version, keyed by method and
+// routeShape of rel.
+type versionEndpoint struct {
+	route   *Route
+	method  string
+	rel     string       // constrained pattern after the version prefix
+	handler http.Handler // without version headers
+}
+
+// versionRoutes adds version headers to versioned routes, and the routes
+// versions inherit and unversioned URLs negotiate (must hold mu).
+func (c *routeCollection) versionRoutes(routes []compiledRoute) []compiledRoute {
+	if len(c.versionSets) == 0 {
+		return routes
+	}
+	// Routes registered outside the versions win over negotiated ones.
+	taken := map[string]bool{}
+	for _, cr := range routes {
+		if cr.route.version == nil && !cr.route.fallback {
+			for _, m := range cr.methods {
+				taken[cr.route.domain+" "+m+" "+routeShape(cr.pattern)] = true
+			}
+		}
+	}
+	var extra []compiledRoute
+	for _, set := range c.versionSets {
+		extra = append(extra, c.expandVersions(set, routes, taken)...)
+	}
+	return append(routes, extra...)
+}
+
+// expandVersions wraps the set's routes in routes with their version
+// headers and returns the routes it adds (must hold mu).
+func (c *routeCollection) expandVersions(set *versionSet, routes []compiledRoute, taken map[string]bool) []compiledRoute {
+	index := make(map[*APIVersion]int, len(set.versions))
+	for i, v := range set.versions {
+		index[v] = i
+	}
+	own := make([]map[string]versionEndpoint, len(set.versions))
+	for i := range own {
+		own[i] = map[string]versionEndpoint{}
+	}
+	for i := range routes {
+		rt := routes[i].route
+		if rt.version == nil || rt.version.set != set || rt.fallback {
+			continue
+		}
+		rel := strings.TrimPrefix(routes[i].pattern, c.constrain(rt, joinPath(set.prefix, rt.version.name)))
+		for _, m := range routes[i].methods {
+			own[index[rt.version]][m+" "+routeShape(rel)] = versionEndpoint{route: rt, method: m, rel: rel, handler: routes[i].handler}
+		}
+		routes[i].handler = versionHeaders(rt.version)(routes[i].handler)
+	}
+
+	var extra []compiledRoute
+	effective := map[string]versionEndpoint{}
+	negotiated := map[string]*negotiator{}
+	var keys []string
+	for i, v := range set.versions {
+		for key, ep := range own[i] {
+			effective[key] = ep
+		}
+		headers := versionHeaders(v)
+		for _, key := range sortedKeys(effective) {
+			ep := effective[key]
+			if ep.route.version != v {
+				extra = append(extra, compiledRoute{
+					route:   ep.route,
+					methods: []string{ep.method},
+					pattern: c.constrain(ep.route, joinPath(set.prefix, v.name)) + ep.rel,
+					handler: headers(ep.handler),
+				})
+			}
+
+			n, ok := negotiated[key]
+			if !ok {
+				n = c.newNegotiator(set)
+				negotiated[key] = n
+				keys = append(keys, key)
+			}
+			n.endpoints[v.name] = negotiatedEndpoint{
+				handler: headers(ep.handler),
+				params:  paramNames(unversioned(c, set, ep)),
+			}
+			n.route, n.pattern = ep.route, unversioned(c, set, ep)
+		}
+	}
+
+	for _, key := range keys {
+		n := negotiated[key]
+		method, _, _ := strings.Cut(key, " ")
+		if taken[n.route.domain+" "+method+" "+routeShape(n.pattern)] {
+			continue
+		}
+		n.params = paramNames(n.pattern)
+		extra = append(extra, compiledRoute{route: n.route, methods: []string{method}, pattern: n.pattern, handler: n})
+	}
+	return extra
+}
+
+// unversioned returns the endpoint's pattern without the version prefix.
+func unversioned(c *routeCollection, set *versionSet, ep versionEndpoint) string {
+	pattern := strings.TrimRight(c.constrain(ep.route, set.prefix), "/") + ep.rel
+	if pattern == "" {
+		return "/"
+	}
+	return pattern
+}
+
+// versionHeaders returns middleware adding v's Deprecation and Sunset
+// headers (must hold mu).
+func versionHeaders(v *APIVersion) func(http.Handler) http.Handler {
+	deprecation, sunset := v.deprecation, v.sunset
+	return func(next http.Handler) http.Handler {
+		if deprecation == "" {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", deprecation)
+			if sunset != "" {
+				w.Header().Set("Sunset", sunset)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func sortedKeys(m map[string]versionEndpoint) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ── negotiation ──────────────────────────────────────────────────────────────
+
+// negotiator serves an unversioned URL with the version the request asks
+// for.
+type negotiator struct {
+	route     *Route   // latest route for the URL, for its domain
+	pattern   string   // mounted pattern
+	params    []string // parameter names in pattern
+	endpoints map[string]negotiatedEndpoint
+	def       string
+	vendor    string
+	header    string
+	routes    *routeCollection
+}
+
+type negotiatedEndpoint struct {
+	handler http.Handler
+	params  []string // parameter names the handler expects
+}
+
+// newNegotiator snapshots the set's settings (must hold mu).
+func (c *routeCollection) newNegotiator(set *versionSet) *negotiator {
+	def := set.def
+	if def == nil {
+		def = set.versions[len(set.versions)-1]
+	}
+	return &negotiator{
+		endpoints: map[string]negotiatedEndpoint{},
+		def:       def.name,
+		vendor:    c.versionVendor,
+		header:    c.versionHeader,
+		routes:    c,
+	}
+}
+
+func (n *negotiator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Accept, "+n.header)
+	ep, ok := n.endpoints[n.requested(r)]
+	if !ok {
+		n.routes.respondStatus(w, r, http.StatusNotFound, "")
+		return
+	}
+	// Versions may name the same parameters differently.
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		keys := rctx.URLParams.Keys
+		for i, from := range n.params {
+			if i >= len(ep.params) || from == ep.params[i] {
+				continue
+			}
+			for k := len(keys) - 1; k >= 0; k-- {
+				if keys[k] == from {
+					keys[k] = ep.params[i]
+					break
+				}
+			}
+		}
+	}
+	ep.handler.ServeHTTP(w, r)
+}
+
+// requested returns the version named by the Accept media type, then the
+// version header, then the default.
+func (n *negotiator) requested(r *http.Request) string {
+	for _, mediaRange := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, _ := strings.Cut(mediaRange, ";")
+		rest, ok := strings.CutPrefix(strings.ToLower(strings.TrimSpace(mediaType)), "application/vnd."+n.vendor+".")
+		if ok {
+			version, _, _ := strings.Cut(rest, "+")
+			return n.normalize(version)
+		}
+	}
+	if version := strings.TrimSpace(r.Header.Get(n.header)); version != "" {
+		return n.normalize(version)
+	}
+	return n.def
+}
+
+// normalize accepts "2" for "v2".
+func (n *negotiator) normalize(version string) string {
+	if _, ok := n.endpoints[version]; !ok {
+		if _, ok := n.endpoints["v"+version]; ok {
+			return "v" + version
+		}
+	}
+	return version
+}
@@ -0,0 +1,203 @@
+package routing_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/km-arc/go-laravel/framework/routing"
+)
+
+// ── helpers ──────────────────────────────────────────────────────────────────
+
+var sunset = time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func versionedRouter() *routing.Router {
+	r := routing.New()
+	r.Prefix("/api", func(api *routing.Router) {
+		api.Version("v1", func(v1 *routing.Router) {
+			v1.Get("/users", write("v1 users")).Name("users.index")
+			v1.Get("/users/{id}", echoParam("id")).Name("users.show")
+		}).Sunset(sunset)
+		api.Version("v2", func(v2 *routing.Router) {
+			v2.Get("/users", write("v2 users")).Name("users.index")
+		})
+	})
+	return r
+}
+
+func doHeaders(t *testing.T, router *routing.Router, method, path string, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+// ── URL prefix ───────────────────────────────────────────────────────────────
+
+func TestVersion_PrefixAndNames(t *testing.T) {
+	r := versionedRouter()
+	if got := do(t, r, http.MethodGet, "/api/v1/users").Body.String(); got != "v1 users" {
+		t.Errorf("v1: got %q", got)
+	}
+	if got := do(t, r, http.MethodGet, "/api/v2/users").Body.String(); got != "v2 users" {
+		t.Errorf("v2: got %q", got)
+	}
+	if got, _ := r.Path("v2.users.index", nil); got != "/api/v2/users" {
+		t.Errorf("name: got %q", got)
+	}
+	if err := r.Validate(); err != nil {
+		t.Errorf("versions should not clash: %v", err)
+	}
+}
+
+func TestVersion_FallsBackToPrevious(t *testing.T) {
+	r := versionedRouter()
+	rr := do(t, r, http.MethodGet, "/api/v2/users/7")
+	if rr.Body.String() != "7" {
+		t.Errorf("got %d %q want v1's handler", rr.Code, rr.Body.String())
+	}
+	if rr.Header().Get("Deprecation") != "" {
+		t.Error("served as v2, so not deprecated")
+	}
+}
+
+// ── Negotiation ──────────────────────────────────────────────────────────────
+
+func TestVersion_Negotiation(t *testing.T) {
+	r := versionedRouter()
+	cases := []struct {
+		name    string
+		headers map[string]string
+		want    string
+	}{
+		{"default is latest", nil, "v2 users"},
+		{"accept", map[string]string{"Accept": "application/vnd.app.v1+json"}, "v1 users"},
+		{"accept among others", map[string]string{"Accept": "text/html, application/vnd.app.v1+json;q=0.9"}, "v1 users"},
+		{"header", map[string]string{"X-API-Version": "v1"}, "v1 users"},
+		{"header number", map[string]string{"X-API-Version": "1"}, "v1 users"},
+	}
+	for _, tc := range cases {
+		if got := doHeaders(t, r, http.MethodGet, "/api/users", tc.headers).Body.String(); got != tc.want {
+			t.Errorf("%s: got %q want %q", tc.name, got, tc.want)
+		}
+	}
+	rr := doHeaders(t, r, http.MethodGet, "/api/users", map[string]string{"X-API-Version": "v9"})
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("unknown version: got %d want 404", rr.Code)
+	}
+	if rr.Header().Get("Vary") == "" {
+		t.Error("negotiated responses should Vary")
+	}
+}
+
+func TestVersion_NegotiationRenamesParams(t *testing.T) {
+	r := routing.New()
+	r.Prefix("/api", func(api *routing.Router) {
+		api.Version("v1", func(v1 *routing.Router) {
+			v1.Get("/posts/{id}", echoParam("id"))
+		})
+		api.Version("v2", func(v2 *routing.Router) {
+			v2.Get("/posts/{post}", echoParam("post"))
+		})
+	})
+	if got := doHeaders(t, r, http.MethodGet, "/api/posts/3", map[string]string{"X-API-Version": "v1"}).Body.String(); got != "3" {
+		t.Errorf("v1: got %q", got)
+	}
+	if got := do(t, r, http.MethodGet, "/api/posts/4").Body.String(); got != "4" {
+		t.Errorf("v2: got %q", got)
+	}
+}
+
+func TestVersion_CustomNegotiationAndDefault(t *testing.T) {
+	r := routing.New()
+	r.VersionNegotiation("acme", "Api-Version")
+	r.Version("v1", func(v1 *routing.Router) { v1.Get("/ping", write("v1")) }).Default()
+	r.Version("v2", func(v2 *routing.Router) { v2.Get("/ping", write("v2")) })
+
+	if got := do(t, r, http.MethodGet, "/ping").Body.String(); got != "v1" {
+		t.Errorf("default: got %q", got)
+	}
+	if got := doHeaders(t, r, http.MethodGet, "/ping", map[string]string{"Accept": "application/vnd.acme.v2+json"}).Body.String(); got != "v2" {
+		t.Errorf("accept: got %q", got)
+	}
+	if got := doHeaders(t, r, http.MethodGet, "/ping", map[string]string{"Api-Version": "2"}).Body.String(); got != "v2" {
+		t.Errorf("header: got %q", got)
+	}
+}
+
+func TestVersion_ExplicitUnversionedRouteWins(t *testing.T) {
+	r := versionedRouter()
+	r.Get("/api/users", write("legacy"))
+	if got := do(t, r, http.MethodGet, "/api/users").Body.String(); got != "legacy" {
+		t.Errorf("got %q want legacy", got)
+	}
+}
+
+// ── Deprecation ──────────────────────────────────────────────────────────────
+
+func TestVersion_DeprecationHeaders(t *testing.T) {
+	r := versionedRouter()
+	rr := do(t, r, http.MethodGet, "/api/v1/users")
+	if rr.Header().Get("Deprecation") != "true" || rr.Header().Get("Sunset") != "Fri, 01 Jan 2027 00:00:00 GMT" {
+		t.Errorf("got Deprecation %q Sunset %q", rr.Header().Get("Deprecation"), rr.Header().Get("Sunset"))
+	}
+	rr = doHeaders(t, r, http.MethodGet, "/api/users", map[string]string{"X-API-Version": "1"})
+	if rr.Header().Get("Deprecation") != "true" {
+		t.Error("negotiated v1 should be deprecated")
+	}
+	if rr := do(t, r, http.MethodGet, "/api/v2/users"); rr.Header().Get("Deprecation") != "" {
+		t.Error("v2 is not deprecated")
+	}
+}
+
+func TestVersion_DeprecatedSince(t *testing.T) {
+	r := routing.New()
+	since := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	r.Version("v1", func(v1 *routing.Router) { v1.Get("/ping", okHandler) }).Deprecated(since)
+	if got := do(t, r, http.MethodGet, "/v1/ping").Header().Get("Deprecation"); got != "@1782864000" {
+		t.Errorf("got %q", got)
+	}
+}
+
+// ── Cache ────────────────────────────────────────────────────────────────────
+
+func TestVersion_Cached(t *testing.T) {
+	build := func(r *routing.Router) {
+		r.RegisterHandler("v1.users", write("v1 users"))
+		r.RegisterHandler("v1.show", echoParam("id"))
+		r.RegisterHandler("v2.users", write("v2 users"))
+	}
+	src := routing.New()
+	build(src)
+	src.Prefix("/api", func(api *routing.Router) {
+		api.Version("v1", func(v1 *routing.Router) {
+			v1.Get("/users", "v1.users")
+			v1.Get("/users/{id}", "v1.show")
+		}).Sunset(sunset).Default()
+		api.Version("v2", func(v2 *routing.Router) { v2.Get("/users", "v2.users") })
+	})
+	var buf bytes.Buffer
+	if err := src.Cache(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	r := routing.New()
+	build(r)
+	if err := r.LoadCache(&buf); err != nil {
+		t.Fatal(err)
+	}
+	rr := do(t, r, http.MethodGet, "/api/users")
+	if rr.Body.String() != "v1 users" || rr.Header().Get("Sunset") == "" {
+		t.Errorf("default v1: got %q Sunset %q", rr.Body.String(), rr.Header().Get("Sunset"))
+	}
+	if got := do(t, r, http.MethodGet, "/api/v2/users/5").Body.String(); got != "5" {
+		t.Errorf("fallback: got %q", got)
+	}
+}
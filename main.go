@@ -7,6 +7,7 @@ import (
 	"github.com/km-arc/go-laravel/framework/container"
 	gohttp "github.com/km-arc/go-laravel/framework/http"
 	"github.com/km-arc/go-laravel/framework/http/validation"
+	"github.com/km-arc/go-laravel/framework/openapi"
 	"github.com/km-arc/go-laravel/framework/routing"
 )
 
@@ -31,6 +32,8 @@ func (p *LogServiceProvider) Register(app *container.Container) {
 	app.Singleton("logger", func(c *container.Container) any {
 		return &Logger{prefix: "APP"}
 	})
+	// Typed route handlers resolve *Logger parameters by type name.
+	app.Alias("logger", container.TypeKey(&Logger{}))
 }
 
 func (p *LogServiceProvider) Boot(app *container.Container) {
@@ -38,6 +41,42 @@ func (p *LogServiceProvider) Boot(app *container.Container) {
 	logger.Info("Application booted ✅")
 }
 
+// ── Example: typed handler ────────────────────────────────────────────────────
+
+// userRules is shared by storeUser and the generated API docs, so they
+// cannot drift.
+var userRules = validation.Rules{
+	"name":  "required|min:2|max:100",
+	"email": "required|email",
+}
+
+// storeUser is a typed handler: parameters are injected, returned errors
+// become responses.
+//
+//	// Laravel: public function store(Request $request, Logger $logger)
+func storeUser(request *gohttp.Request, res *gohttp.Response, logger *Logger) error {
+	var body struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+	if err := request.Bind(&body); err != nil {
+		return routing.Abort(http.StatusBadRequest, err.Error())
+	}
+
+	v := validation.Make(map[string]string{
+		"name":  body.Name,
+		"email": body.Email,
+	}, userRules)
+
+	if v.Fails() {
+		return v.Errors() // 422
+	}
+
+	logger.Info("Creating user: " + body.Name)
+	res.Created(map[string]any{"name": body.Name, "email": body.Email})
+	return nil
+}
+
 // ── main ──────────────────────────────────────────────────────────────────────
 
 func main() {
@@ -49,57 +88,36 @@ func main() {
 	//    Laravel: $app->register(new LogServiceProvider($app))
 	application.Register(&LogServiceProvider{})
 
-	// 3. Register routes (resolve router from container)
-	//    Laravel: Route::get('/', ...)
+	// 3. Register handlers by key (resolve router from container), so the
+	//    routes can be cached with `go run . route:cache`
+	//    Laravel: [UserController::class, 'store']
 	r := application.Router()
 
-	r.Get("/", func(w http.ResponseWriter, req *http.Request) {
+	r.RegisterHandler("home", func(w http.ResponseWriter, req *http.Request) {
 		gohttp.NewResponse(w).Success(map[string]any{
 			"app":     application.Config().App.Name,
 			"version": application.Version(),
 			"env":     application.Environment(),
 		})
 	})
+	r.RegisterHandler("users.store", storeUser)
 
-	// 4. API prefix group
-	r.Prefix("/api/v1", func(api *routing.Router) {
-
-		api.Post("/users", func(w http.ResponseWriter, req *http.Request) {
-			request := gohttp.NewRequest(req)
-			res := gohttp.NewResponse(w)
-
-			var body struct {
-				Name  string `json:"name"`
-				Email string `json:"email"`
-			}
-			if err := request.Bind(&body); err != nil {
-				res.Error(http.StatusBadRequest, err.Error())
-				return
-			}
-
-			v := validation.Make(map[string]string{
-				"name":  body.Name,
-				"email": body.Email,
-			}, validation.Rules{
-				"name":  "required|min:2|max:100",
-				"email": "required|email",
-			})
-
-			if v.Fails() {
-				res.ValidationError(v.Errors())
-				return
-			}
-
-			// Resolve logger from container anywhere in your app
-			logger := container.Resolve[*Logger](application.Container, "logger")
-			logger.Info("Creating user: " + body.Name)
-
-			res.Created(map[string]any{"name": body.Name, "email": body.Email})
-		})
+	// 4. Register routes — loaded from bootstrap/cache/routes.json when cached
+	//    Laravel: routes/web.php, routes/api.php
+	application.Routes(func(r *routing.Router) {
+		r.Get("/", "home")
 
+		// API prefix group — the "api" middleware group throttles to 60/min per IP
+		//    Laravel: Route::middleware('api')->prefix('api/v1')->group(...)
+		r.With("api").Prefix("/api/v1", func(api *routing.Router) {
+			api.Post("/users", "users.store").Name("users.store").Summary("Create a user").Rules(userRules)
+		})
 	})
 
-	// 5. Boot + run
+	// 5. API docs — GET /openapi.json, browsable at /docs
+	openapi.Register(r, openapi.Info{Title: "Go-Laravel API", Version: application.Version()}, "/docs")
+
+	// 6. Boot + run
 	//    Laravel: $kernel->handle(Request::capture())
 	application.Run()
 }
\ No newline at end of file